@@ -0,0 +1,32 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import "unsafe"
+
+// Some system calls, such as bpf(2), clone3(2) and io_uring, take
+// structures whose pointer fields are 64-bit integers regardless of the
+// architecture. Storing a Go pointer in such a field hides it from the
+// compiler: the memory it points to may be on the goroutine stack, which is
+// moved when the stack grows, leaving the kernel with a stale address.
+// kernelAddr is the one place such fields are filled in.
+
+// kernelAddrSink is never enabled. Because kernelAddr stores its argument
+// in it, escape analysis moves everything passed to kernelAddr to the heap,
+// where it is never moved.
+var kernelAddrSink struct {
+	enabled bool
+	p       unsafe.Pointer
+}
+
+// kernelAddr returns the address p as the value of a 64-bit pointer field
+// of a structure passed to the kernel. The caller must keep the memory
+// alive, with runtime.KeepAlive, until the kernel is done with it.
+func kernelAddr(p unsafe.Pointer) uint64 {
+	if kernelAddrSink.enabled {
+		kernelAddrSink.p = p
+	}
+	return uint64(uintptr(p))
+}
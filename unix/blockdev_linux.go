@@ -0,0 +1,216 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Block device inventory read from sysfs.
+
+package unix
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// sysfsSectorSize is the unit used by sysfs for block device sizes and
+// offsets, regardless of the device's logical block size.
+const sysfsSectorSize = 512
+
+// BlockDevice describes a block device listed in /sys/block.
+type BlockDevice struct {
+	Name       string
+	SizeBytes  uint64
+	Removable  bool
+	Rotational bool
+	Major      uint32
+	Minor      uint32
+}
+
+// BlockDevices returns the block devices known to the kernel, as listed in
+// /sys/block. Partitions are not included; see Partitions.
+func BlockDevices() ([]BlockDevice, error) {
+	entries, err := os.ReadDir("/sys/block")
+	if err != nil {
+		return nil, err
+	}
+	devs := make([]BlockDevice, 0, len(entries))
+	for _, e := range entries {
+		dir := filepath.Join("/sys/block", e.Name())
+		dev := BlockDevice{Name: e.Name()}
+		sectors, err := readSysfsUint(filepath.Join(dir, "size"))
+		if err != nil {
+			return nil, err
+		}
+		dev.SizeBytes = sectors * sysfsSectorSize
+		if dev.Major, dev.Minor, err = readSysfsDev(filepath.Join(dir, "dev")); err != nil {
+			return nil, err
+		}
+		if v, err := readSysfsUint(filepath.Join(dir, "removable")); err == nil {
+			dev.Removable = v != 0
+		}
+		// Not every device has a request queue, e.g. device-mapper
+		// targets without a table. Treat those as non-rotational.
+		if v, err := readSysfsUint(filepath.Join(dir, "queue", "rotational")); err == nil {
+			dev.Rotational = v != 0
+		}
+		devs = append(devs, dev)
+	}
+	return devs, nil
+}
+
+// readSysfsString returns the contents of the sysfs attribute at path with
+// the trailing newline removed.
+func readSysfsString(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// readSysfsUint parses the sysfs attribute at path as an unsigned decimal
+// integer.
+func readSysfsUint(path string) (uint64, error) {
+	s, err := readSysfsString(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// readSysfsDev parses a "major:minor" device number attribute at path.
+func readSysfsDev(path string) (major, minor uint32, err error) {
+	s, err := readSysfsString(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	return parseMajorMinor(s)
+}
+
+// parseMajorMinor parses a device number in "major:minor" notation.
+func parseMajorMinor(s string) (major, minor uint32, err error) {
+	maj, min, ok := strings.Cut(s, ":")
+	if !ok {
+		return 0, 0, EINVAL
+	}
+	ma, err := strconv.ParseUint(maj, 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	mi, err := strconv.ParseUint(min, 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	return uint32(ma), uint32(mi), nil
+}
+
+// Partition describes a partition of a block device. Offsets and sizes are
+// in units of 512-byte sectors, as reported by sysfs.
+type Partition struct {
+	Name        string
+	StartSector uint64
+	SizeSectors uint64
+}
+
+// Partitions returns the partitions of the block device with the given name,
+// such as "sda" or "nvme0n1", as listed under /sys/block/<device>. The
+// partitions are sorted by their starting sector.
+func Partitions(device string) ([]Partition, error) {
+	if device == "" || strings.Contains(device, "/") {
+		return nil, EINVAL
+	}
+	dir := filepath.Join("/sys/block", device)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var parts []Partition
+	for _, e := range entries {
+		pdir := filepath.Join(dir, e.Name())
+		// Only partition directories carry a "partition" attribute.
+		if _, err := os.Stat(filepath.Join(pdir, "partition")); err != nil {
+			continue
+		}
+		start, err := readSysfsUint(filepath.Join(pdir, "start"))
+		if err != nil {
+			return nil, err
+		}
+		size, err := readSysfsUint(filepath.Join(pdir, "size"))
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, Partition{Name: e.Name(), StartSector: start, SizeSectors: size})
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].StartSector < parts[j].StartSector })
+	return parts, nil
+}
+
+// DiskStat holds the I/O statistics of a block device as reported in
+// /proc/diskstats. Sector counts are in units of 512 bytes.
+type DiskStat struct {
+	ReadsCompleted  uint64
+	SectorsRead     uint64
+	WritesCompleted uint64
+	SectorsWritten  uint64
+	IoInProgress    uint64
+	IoTimeMs        uint64
+}
+
+// DiskStats returns the I/O statistics of all block devices and partitions,
+// keyed by device name, as read from /proc/diskstats.
+func DiskStats() (map[string]DiskStat, error) {
+	b, err := os.ReadFile("/proc/diskstats")
+	if err != nil {
+		return nil, err
+	}
+	stats := make(map[string]DiskStat)
+	for _, line := range strings.Split(string(b), "\n") {
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		// major minor name reads merged sectors ms writes merged
+		// sectors ms in-progress io-ms weighted-io-ms ...
+		if len(f) < 14 {
+			return nil, EINVAL
+		}
+		var v [11]uint64
+		for i := range v {
+			if v[i], err = strconv.ParseUint(f[3+i], 10, 64); err != nil {
+				return nil, err
+			}
+		}
+		stats[f[2]] = DiskStat{
+			ReadsCompleted:  v[0],
+			SectorsRead:     v[2],
+			WritesCompleted: v[4],
+			SectorsWritten:  v[6],
+			IoInProgress:    v[8],
+			IoTimeMs:        v[9],
+		}
+	}
+	return stats, nil
+}
+
+// PathDevice returns the device number and name of the block device backing
+// the file system containing path. The name is the kernel's name for the
+// device or partition, such as "sda1", as found via /sys/dev/block.
+//
+// If the file system is not backed by a block device, as is the case for
+// tmpfs, overlayfs and other virtual file systems, PathDevice returns the
+// device number recorded in the file's metadata along with ENODEV.
+func PathDevice(path string) (major, minor uint32, device string, err error) {
+	var st Stat_t
+	if err := Stat(path, &st); err != nil {
+		return 0, 0, "", err
+	}
+	major, minor = Major(uint64(st.Dev)), Minor(uint64(st.Dev))
+	link := "/sys/dev/block/" + strconv.FormatUint(uint64(major), 10) + ":" + strconv.FormatUint(uint64(minor), 10)
+	target, err := os.Readlink(link)
+	if err != nil {
+		return major, minor, "", ENODEV
+	}
+	return major, minor, filepath.Base(target), nil
+}
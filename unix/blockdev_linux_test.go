@@ -0,0 +1,238 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestBlockDevices(t *testing.T) {
+	if _, err := os.Stat("/sys/block"); err != nil {
+		t.Skipf("sysfs not available: %v", err)
+	}
+	devs, err := unix.BlockDevices()
+	if err != nil {
+		t.Fatalf("BlockDevices: %v", err)
+	}
+	if len(devs) == 0 {
+		t.Skip("no block devices found")
+	}
+
+	// Unattached loop devices and empty removable drives report a size
+	// of zero, so only require that some device has a positive size.
+	sized := false
+	for _, dev := range devs {
+		t.Logf("%+v", dev)
+		if dev.Name == "" {
+			t.Errorf("block device %d:%d has no name", dev.Major, dev.Minor)
+		}
+		if dev.SizeBytes > 0 {
+			sized = true
+		}
+	}
+	if !sized {
+		t.Errorf("no block device reports a positive size")
+	}
+}
+
+// rootDisk returns the name of the whole-disk block device backing the root
+// file system, or the empty string if it cannot be determined.
+func rootDisk(t *testing.T) string {
+	var st unix.Stat_t
+	if err := unix.Stat("/", &st); err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+	link := fmt.Sprintf("/sys/dev/block/%d:%d", unix.Major(uint64(st.Dev)), unix.Minor(uint64(st.Dev)))
+	dir, err := filepath.EvalSymlinks(link)
+	if err != nil {
+		return ""
+	}
+	if _, err := os.Stat(filepath.Join(dir, "partition")); err == nil {
+		dir = filepath.Dir(dir)
+	}
+	name := filepath.Base(dir)
+	if _, err := os.Stat(filepath.Join("/sys/block", name)); err != nil {
+		return ""
+	}
+	return name
+}
+
+func TestPartitions(t *testing.T) {
+	disk := rootDisk(t)
+	if disk == "" {
+		t.Skip("cannot determine the disk backing the root file system")
+	}
+	parts, err := unix.Partitions(disk)
+	if err != nil {
+		t.Fatalf("Partitions(%q): %v", disk, err)
+	}
+	for i, p := range parts {
+		t.Logf("%s: %+v", disk, p)
+		if i > 0 && p.StartSector < parts[i-1].StartSector {
+			t.Errorf("partition %s starts at sector %d, before preceding partition %s at %d",
+				p.Name, p.StartSector, parts[i-1].Name, parts[i-1].StartSector)
+		}
+	}
+
+	if _, err := unix.Partitions("../block"); err != unix.EINVAL {
+		t.Errorf("Partitions with a path: got error %v, want %v", err, unix.EINVAL)
+	}
+}
+
+// attachLoop attaches a new loop device to the file descriptor fd with the
+// given flags. The device is detached when the test completes.
+func attachLoop(t *testing.T, fd int, flags uint32) (name string, loopfd int) {
+	ctl, err := unix.Open("/dev/loop-control", unix.O_RDWR|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Skipf("skipping test: %v", err)
+	}
+	defer unix.Close(ctl)
+	n, err := unix.IoctlRetInt(ctl, unix.LOOP_CTL_GET_FREE)
+	if err != nil {
+		t.Skipf("skipping test, no free loop device: %v", err)
+	}
+	name = fmt.Sprintf("loop%d", n)
+	loopfd, err = unix.Open("/dev/"+name, unix.O_RDWR|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Skipf("skipping test: %v", err)
+	}
+	cfg := unix.LoopConfig{Fd: uint32(fd)}
+	cfg.Info.Flags = flags
+	if err := unix.IoctlLoopConfigure(loopfd, &cfg); err != nil {
+		unix.Close(loopfd)
+		t.Skipf("skipping test, LOOP_CONFIGURE: %v", err)
+	}
+	t.Cleanup(func() {
+		unix.IoctlSetInt(loopfd, unix.LOOP_CLR_FD, 0)
+		unix.Close(loopfd)
+	})
+	return name, loopfd
+}
+
+func TestIoctlBlkRRPart(t *testing.T) {
+	if unix.Geteuid() != 0 {
+		t.Skip("skipping test, must be root")
+	}
+
+	const sectors = 8192
+	f, err := os.Create(filepath.Join(t.TempDir(), "disk.img"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := f.Truncate(sectors * 512); err != nil {
+		t.Fatal(err)
+	}
+	name, loopfd := attachLoop(t, int(f.Fd()), unix.LO_FLAGS_PARTSCAN)
+
+	// Write an MBR with a single Linux partition covering sectors
+	// 2048 to 6143 to the otherwise empty device.
+	var mbr [512]byte
+	entry := mbr[446:462]
+	entry[4] = 0x83
+	binary.LittleEndian.PutUint32(entry[8:], 2048)
+	binary.LittleEndian.PutUint32(entry[12:], 4096)
+	mbr[510], mbr[511] = 0x55, 0xaa
+	if _, err := unix.Pwrite(loopfd, mbr[:], 0); err != nil {
+		t.Fatalf("writing partition table: %v", err)
+	}
+	if err := unix.Fsync(loopfd); err != nil {
+		t.Fatalf("Fsync: %v", err)
+	}
+
+	if err := unix.IoctlBlkRRPart(loopfd); err != nil {
+		t.Fatalf("IoctlBlkRRPart: %v", err)
+	}
+
+	parts, err := unix.Partitions(name)
+	if err != nil {
+		t.Fatalf("Partitions(%q): %v", name, err)
+	}
+	if len(parts) == 0 {
+		t.Skip("skipping test, kernel does not support MBR partition tables")
+	}
+	want := []unix.Partition{{Name: name + "p1", StartSector: 2048, SizeSectors: 4096}}
+	if !reflect.DeepEqual(parts, want) {
+		t.Errorf("Partitions(%q) after IoctlBlkRRPart = %+v, want %+v", name, parts, want)
+	}
+}
+
+func TestDiskStats(t *testing.T) {
+	stats, err := unix.DiskStats()
+	if err != nil {
+		t.Fatalf("DiskStats: %v", err)
+	}
+
+	// Find the device backing the temporary directory, if any.
+	dir := t.TempDir()
+	var st unix.Stat_t
+	if err := unix.Stat(dir, &st); err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+	link := fmt.Sprintf("/sys/dev/block/%d:%d", unix.Major(uint64(st.Dev)), unix.Minor(uint64(st.Dev)))
+	target, err := filepath.EvalSymlinks(link)
+	if err != nil {
+		t.Skipf("skipping test, %s is not backed by a block device", dir)
+	}
+	name := filepath.Base(target)
+	before, ok := stats[name]
+	if !ok {
+		t.Fatalf("DiskStats: device %s backing %s not found", name, dir)
+	}
+
+	f, err := os.Create(filepath.Join(dir, "data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(make([]byte, 1<<20)); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err = unix.DiskStats()
+	if err != nil {
+		t.Fatalf("DiskStats: %v", err)
+	}
+	after := stats[name]
+	t.Logf("%s: before %+v, after %+v", name, before, after)
+	if after.WritesCompleted <= before.WritesCompleted || after.SectorsWritten <= before.SectorsWritten {
+		t.Skipf("skipping test, no writes to %s were recorded", name)
+	}
+}
+
+func TestPathDevice(t *testing.T) {
+	dir := t.TempDir()
+	major, minor, device, err := unix.PathDevice(dir)
+	if err == unix.ENODEV {
+		t.Skipf("skipping test, %s (%d:%d) is not backed by a block device", dir, major, minor)
+	} else if err != nil {
+		t.Fatalf("PathDevice(%q): %v", dir, err)
+	}
+	t.Logf("%s is on %s (%d:%d)", dir, device, major, minor)
+
+	got, err := os.ReadFile(filepath.Join("/sys/class/block", device, "dev"))
+	if err != nil {
+		t.Fatalf("device %q not found in sysfs: %v", device, err)
+	}
+	if want := fmt.Sprintf("%d:%d\n", major, minor); string(got) != want {
+		t.Errorf("device %q has number %q, want %q", device, got, want)
+	}
+
+	if _, _, _, err := unix.PathDevice("/proc"); err != unix.ENODEV {
+		t.Errorf("PathDevice(/proc): got error %v, want %v", err, unix.ENODEV)
+	}
+}
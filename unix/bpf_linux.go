@@ -0,0 +1,34 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Construction of classic BPF programs, as used by socket filters and
+// seccomp.
+
+package unix
+
+// BPFInstructions is a classic BPF program.
+type BPFInstructions []SockFilter
+
+// BPFStmt returns the classic BPF instruction with the given opcode and
+// constant operand, like the BPF_STMT macro in C.
+func BPFStmt(code uint16, k uint32) SockFilter {
+	return SockFilter{Code: code, K: k}
+}
+
+// BPFJump returns the classic BPF conditional jump with the given opcode,
+// constant operand and jump offsets for the true and false cases, like the
+// BPF_JUMP macro in C.
+func BPFJump(code uint16, k uint32, jt, jf uint8) SockFilter {
+	return SockFilter{Code: code, Jt: jt, Jf: jf, K: k}
+}
+
+// SockFprog returns a SockFprog referring to the program, for use with
+// SetsockoptAttachFilter or seccomp. It returns EINVAL if the program is
+// empty or longer than BPF_MAXINSNS instructions.
+func (insns BPFInstructions) SockFprog() (*SockFprog, error) {
+	if len(insns) == 0 || len(insns) > BPF_MAXINSNS {
+		return nil, EINVAL
+	}
+	return &SockFprog{Len: uint16(len(insns)), Filter: &insns[0]}, nil
+}
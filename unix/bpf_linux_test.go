@@ -0,0 +1,51 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestBPFInstructions(t *testing.T) {
+	if got, want := unix.BPFJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, 17, 1, 2),
+		(unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, Jt: 1, Jf: 2, K: 17}); got != want {
+		t.Errorf("BPFJump = %+v, want %+v", got, want)
+	}
+
+	// Accept every packet, but only after checking the protocol byte of
+	// the IP header to exercise a jump.
+	prog := unix.BPFInstructions{
+		unix.BPFStmt(unix.BPF_LD|unix.BPF_B|unix.BPF_ABS, 9),
+		unix.BPFJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.IPPROTO_UDP, 0, 0),
+		unix.BPFStmt(unix.BPF_RET|unix.BPF_K, 0xffffffff),
+	}
+	fprog, err := prog.SockFprog()
+	if err != nil {
+		t.Fatalf("SockFprog: %v", err)
+	}
+	if int(fprog.Len) != len(prog) || fprog.Filter != &prog[0] {
+		t.Errorf("SockFprog = %+v, want Len %d referring to the program", fprog, len(prog))
+	}
+
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Socket: %v", err)
+	}
+	defer unix.Close(fd)
+	if err := unix.SetsockoptAttachFilter(fd, fprog); err != nil {
+		t.Fatalf("SetsockoptAttachFilter: %v", err)
+	}
+
+	if _, err := (unix.BPFInstructions{}).SockFprog(); err != unix.EINVAL {
+		t.Errorf("SockFprog of empty program: got %v, want EINVAL", err)
+	}
+	if _, err := make(unix.BPFInstructions, unix.BPF_MAXINSNS+1).SockFprog(); err != unix.EINVAL {
+		t.Errorf("SockFprog of oversized program: got %v, want EINVAL", err)
+	}
+}
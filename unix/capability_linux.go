@@ -0,0 +1,194 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Helpers for process capabilities, see capabilities(7).
+
+package unix
+
+// HaveCapability reports whether cap, one of the CAP_* constants, is in
+// the effective capability set of the calling thread.
+func HaveCapability(cap uintptr) (bool, error) {
+	var data [2]CapUserData
+	if cap >= uintptr(len(data))*32 {
+		return false, EINVAL
+	}
+	hdr := CapUserHeader{Version: LINUX_CAPABILITY_VERSION_3}
+	if err := Capget(&hdr, &data[0]); err != nil {
+		return false, err
+	}
+	return data[cap/32].Effective&(1<<(cap%32)) != 0, nil
+}
+
+// CapBoundingRead reports whether cap is in the capability bounding set of
+// the calling thread.
+func CapBoundingRead(cap uintptr) (bool, error) {
+	ret, err := PrctlRetInt(PR_CAPBSET_READ, cap, 0, 0, 0)
+	if err != nil {
+		return false, err
+	}
+	return ret == 1, nil
+}
+
+// CapBoundingDrop removes cap from the capability bounding set of the
+// calling thread. The capability can never be regained by the thread or by
+// processes it executes. The bounding set is per thread, so callers should
+// lock the goroutine to its thread with runtime.LockOSThread, or drop the
+// capability before starting other threads. It requires CAP_SETPCAP.
+func CapBoundingDrop(cap uintptr) error {
+	return Prctl(PR_CAPBSET_DROP, cap, 0, 0, 0)
+}
+
+// GetSecurebits returns the securebits flags of the calling thread, a
+// combination of the SECBIT_* constants.
+func GetSecurebits() (int, error) {
+	return PrctlRetInt(PR_GET_SECUREBITS, 0, 0, 0, 0)
+}
+
+// SetSecurebits sets the securebits flags of the calling thread to bits, a
+// combination of the SECBIT_* constants. Each SECBIT_*_LOCKED flag makes
+// the flag it accompanies immutable; attempting to change a locked flag, or
+// to clear a lock, fails with EPERM. Like the bounding set, the flags are
+// per thread. It requires CAP_SETPCAP.
+func SetSecurebits(bits int) error {
+	return Prctl(PR_SET_SECUREBITS, uintptr(bits), 0, 0, 0)
+}
+
+// CapabilityKind selects one of the capability sets of a thread in a
+// CapabilitySet.
+type CapabilityKind int
+
+const (
+	CapEffective CapabilityKind = iota
+	CapPermitted
+	CapInheritable
+	CapAmbient
+)
+
+// CapabilitySet holds the capability sets of a thread as bit masks indexed
+// by the CAP_* constants. It is read with Get, modified in memory with
+// Raise and Lower, and applied with Set, like the cap_t of libcap.
+//
+// Capabilities are per thread: callers modifying them should lock the
+// goroutine to its thread with runtime.LockOSThread, or change them before
+// starting other threads.
+type CapabilitySet struct {
+	Effective   uint64
+	Permitted   uint64
+	Inheritable uint64
+	Ambient     uint64
+}
+
+// Get reads the capability sets of the calling thread into c. Ambient is
+// left zero on kernels without ambient capabilities.
+func (c *CapabilitySet) Get() error {
+	hdr := CapUserHeader{Version: LINUX_CAPABILITY_VERSION_3}
+	var data [2]CapUserData
+	if err := Capget(&hdr, &data[0]); err != nil {
+		return err
+	}
+	c.Effective = uint64(data[0].Effective) | uint64(data[1].Effective)<<32
+	c.Permitted = uint64(data[0].Permitted) | uint64(data[1].Permitted)<<32
+	c.Inheritable = uint64(data[0].Inheritable) | uint64(data[1].Inheritable)<<32
+	c.Ambient = 0
+	for cap := uintptr(0); cap < 64; cap++ {
+		ret, err := PrctlRetInt(PR_CAP_AMBIENT, PR_CAP_AMBIENT_IS_SET, cap, 0, 0)
+		if err == EINVAL {
+			// Past the last capability known to the kernel.
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if ret == 1 {
+			c.Ambient |= 1 << cap
+		}
+	}
+	return nil
+}
+
+// Set applies c to the calling thread. The effective, permitted and
+// inheritable sets are changed first, subject to the rules of capset(2),
+// then the ambient set is replaced; its capabilities must be both
+// permitted and inheritable.
+//
+// Set is not atomic. If it fails after the first step, the effective,
+// permitted and inheritable sets keep their new values and the ambient set
+// holds only part of c.Ambient, possibly none of it; Get reports the
+// resulting state.
+func (c *CapabilitySet) Set() error {
+	hdr := CapUserHeader{Version: LINUX_CAPABILITY_VERSION_3}
+	data := [2]CapUserData{
+		{
+			Effective:   uint32(c.Effective),
+			Permitted:   uint32(c.Permitted),
+			Inheritable: uint32(c.Inheritable),
+		},
+		{
+			Effective:   uint32(c.Effective >> 32),
+			Permitted:   uint32(c.Permitted >> 32),
+			Inheritable: uint32(c.Inheritable >> 32),
+		},
+	}
+	if err := Capset(&hdr, &data[0]); err != nil {
+		return err
+	}
+	err := Prctl(PR_CAP_AMBIENT, PR_CAP_AMBIENT_CLEAR_ALL, 0, 0, 0)
+	if err == EINVAL && c.Ambient == 0 {
+		// No ambient capabilities on this kernel.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for cap := uintptr(0); cap < 64; cap++ {
+		if c.Ambient&(1<<cap) == 0 {
+			continue
+		}
+		if err := Prctl(PR_CAP_AMBIENT, PR_CAP_AMBIENT_RAISE, cap, 0, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// set returns the bit mask of c selected by kind.
+func (c *CapabilitySet) set(kind CapabilityKind) *uint64 {
+	switch kind {
+	case CapEffective:
+		return &c.Effective
+	case CapPermitted:
+		return &c.Permitted
+	case CapInheritable:
+		return &c.Inheritable
+	case CapAmbient:
+		return &c.Ambient
+	}
+	return nil
+}
+
+// Has reports whether cap is in the set of c selected by kind.
+func (c *CapabilitySet) Has(kind CapabilityKind, cap uintptr) bool {
+	s := c.set(kind)
+	return s != nil && *s&(1<<cap) != 0
+}
+
+// Raise adds caps to the set of c selected by kind. The change takes effect
+// when Set is called.
+func (c *CapabilitySet) Raise(kind CapabilityKind, caps ...uintptr) {
+	if s := c.set(kind); s != nil {
+		for _, cap := range caps {
+			*s |= 1 << cap
+		}
+	}
+}
+
+// Lower removes caps from the set of c selected by kind. The change takes
+// effect when Set is called.
+func (c *CapabilitySet) Lower(kind CapabilityKind, caps ...uintptr) {
+	if s := c.set(kind); s != nil {
+		for _, cap := range caps {
+			*s &^= 1 << cap
+		}
+	}
+}
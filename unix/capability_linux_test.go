@@ -0,0 +1,183 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// threadCapEff returns the effective capability set of the calling thread
+// as reported by /proc/thread-self/status.
+func threadCapEff(t *testing.T) uint64 {
+	eff, err := threadCaps("CapEff")
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		t.Fatal(err)
+	} else if err != nil {
+		t.Skip(err)
+	}
+	return eff
+}
+
+// threadCaps returns the capability set of the calling thread reported in
+// the given field of /proc/thread-self/status. Unlike threadCapEff, it may
+// be called from any goroutine.
+func threadCaps(field string) (uint64, error) {
+	b, err := os.ReadFile("/proc/thread-self/status")
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		if v, ok := strings.CutPrefix(line, field+":"); ok {
+			return strconv.ParseUint(strings.TrimSpace(v), 16, 64)
+		}
+	}
+	return 0, fmt.Errorf("no %s in /proc/thread-self/status", field)
+}
+
+func TestHaveCapability(t *testing.T) {
+	// Root usually holds CAP_SYS_ADMIN and others do not, but containers
+	// commonly drop it from root, so trust the kernel's own report.
+	want := threadCapEff(t)&(1<<unix.CAP_SYS_ADMIN) != 0
+	got, err := unix.HaveCapability(unix.CAP_SYS_ADMIN)
+	if err != nil {
+		t.Fatalf("HaveCapability: %v", err)
+	}
+	if got != want {
+		t.Errorf("HaveCapability(CAP_SYS_ADMIN) = %v, want %v (euid %d)", got, want, unix.Geteuid())
+	}
+	if _, err := unix.HaveCapability(64); err != unix.EINVAL {
+		t.Errorf("HaveCapability(64): got %v, want EINVAL", err)
+	}
+}
+
+func TestCapBoundingDrop(t *testing.T) {
+	if ok, err := unix.CapBoundingRead(unix.CAP_SYS_BOOT); err != nil {
+		t.Fatalf("CapBoundingRead: %v", err)
+	} else if !ok {
+		t.Skip("CAP_SYS_BOOT is not in the bounding set")
+	}
+	if _, err := unix.CapBoundingRead(1 << 20); err != unix.EINVAL {
+		t.Errorf("CapBoundingRead of an unknown capability: got %v, want EINVAL", err)
+	}
+
+	// The bounding set is per thread and cannot be restored.
+	runOnLockedThread(t, func() (bool, error) {
+		if err := unix.CapBoundingDrop(unix.CAP_SYS_BOOT); err != nil {
+			return err == unix.EPERM, fmt.Errorf("CapBoundingDrop: %v", err)
+		}
+		if ok, err := unix.CapBoundingRead(unix.CAP_SYS_BOOT); err != nil || ok {
+			return false, fmt.Errorf("CapBoundingRead after drop = %v, %v; want false", ok, err)
+		}
+
+		// A capability outside the bounding set cannot be added back to
+		// the inheritable set.
+		hdr := unix.CapUserHeader{Version: unix.LINUX_CAPABILITY_VERSION_3}
+		var data [2]unix.CapUserData
+		if err := unix.Capget(&hdr, &data[0]); err != nil {
+			return false, fmt.Errorf("Capget: %v", err)
+		}
+		if data[0].Inheritable&(1<<unix.CAP_SYS_BOOT) == 0 {
+			data[0].Inheritable |= 1 << unix.CAP_SYS_BOOT
+			if err := unix.Capset(&hdr, &data[0]); err != unix.EPERM {
+				return false, fmt.Errorf("Capset adding dropped CAP_SYS_BOOT: got %v, want EPERM", err)
+			}
+		}
+		return false, nil
+	})
+	if ok, err := unix.CapBoundingRead(unix.CAP_SYS_BOOT); err != nil || !ok {
+		t.Errorf("CAP_SYS_BOOT missing from the bounding set of another thread: %v, %v", ok, err)
+	}
+}
+
+func TestSecurebits(t *testing.T) {
+	// Locked securebits cannot be cleared.
+	runOnLockedThread(t, func() (bool, error) {
+		orig, err := unix.GetSecurebits()
+		if err != nil {
+			return false, fmt.Errorf("GetSecurebits: %v", err)
+		}
+		if orig&unix.SECBIT_KEEP_CAPS_LOCKED != 0 {
+			return true, fmt.Errorf("SECBIT_KEEP_CAPS is already locked: %#x", orig)
+		}
+		want := orig | unix.SECBIT_KEEP_CAPS | unix.SECBIT_KEEP_CAPS_LOCKED
+		if err := unix.SetSecurebits(want); err != nil {
+			return err == unix.EPERM, fmt.Errorf("SetSecurebits: %v", err)
+		}
+		if got, err := unix.GetSecurebits(); err != nil || got != want {
+			return false, fmt.Errorf("GetSecurebits = %#x, %v; want %#x", got, err, want)
+		}
+		if err := unix.SetSecurebits(want &^ unix.SECBIT_KEEP_CAPS); err != unix.EPERM {
+			return false, fmt.Errorf("clearing locked SECBIT_KEEP_CAPS: got %v, want EPERM", err)
+		}
+		return false, nil
+	})
+}
+
+func TestCapabilitySet(t *testing.T) {
+	var c unix.CapabilitySet
+	c.Raise(unix.CapPermitted, unix.CAP_CHOWN, unix.CAP_KILL)
+	c.Lower(unix.CapPermitted, unix.CAP_CHOWN)
+	if c.Has(unix.CapPermitted, unix.CAP_CHOWN) || !c.Has(unix.CapPermitted, unix.CAP_KILL) || c.Permitted != 1<<unix.CAP_KILL {
+		t.Errorf("Raise and Lower: got permitted set %#x", c.Permitted)
+	}
+	if c.Has(unix.CapEffective, unix.CAP_KILL) {
+		t.Errorf("Raise of the permitted set changed the effective set")
+	}
+
+	// This changes the capabilities of the thread.
+	runOnLockedThread(t, func() (bool, error) {
+		var c unix.CapabilitySet
+		if err := c.Get(); err != nil {
+			return false, fmt.Errorf("Get: %v", err)
+		}
+		if eff, err := threadCaps("CapEff"); err != nil || c.Effective != eff {
+			return false, fmt.Errorf("Get: effective set %#x, want %#x (%v)", c.Effective, eff, err)
+		}
+		if !c.Has(unix.CapEffective, unix.CAP_NET_RAW) || !c.Has(unix.CapPermitted, unix.CAP_NET_RAW) {
+			return true, fmt.Errorf("CAP_NET_RAW is not held")
+		}
+
+		c.Lower(unix.CapEffective, unix.CAP_NET_RAW)
+		c.Raise(unix.CapInheritable, unix.CAP_NET_RAW)
+		c.Raise(unix.CapAmbient, unix.CAP_NET_RAW)
+		if err := c.Set(); err != nil {
+			return err == unix.EPERM, fmt.Errorf("Set: %v", err)
+		}
+		if eff, err := threadCaps("CapEff"); err != nil || eff&(1<<unix.CAP_NET_RAW) != 0 {
+			return false, fmt.Errorf("effective set after Set: %#x, %v; want CAP_NET_RAW lowered", eff, err)
+		}
+		if amb, err := threadCaps("CapAmb"); err != nil || amb != c.Ambient {
+			return false, fmt.Errorf("ambient set after Set: %#x, %v; want %#x", amb, err, c.Ambient)
+		}
+		var got unix.CapabilitySet
+		if err := got.Get(); err != nil || got != c {
+			return false, fmt.Errorf("Get after Set = %+v, %v; want %+v", got, err, c)
+		}
+
+		// Capabilities outside the permitted set cannot be made ambient.
+		c.Lower(unix.CapPermitted, unix.CAP_NET_RAW)
+		c.Lower(unix.CapAmbient, unix.CAP_NET_RAW)
+		c.Raise(unix.CapAmbient, unix.CAP_SYS_BOOT)
+		if err := c.Set(); err != unix.EPERM {
+			return false, fmt.Errorf("Set with a non-permitted ambient capability: got %v, want EPERM", err)
+		}
+		// The failed Set has still applied the other sets and cleared the
+		// ambient set.
+		if err := got.Get(); err != nil || got.Permitted != c.Permitted || got.Ambient != 0 {
+			return false, fmt.Errorf("Get after a failed Set = %+v, %v; want permitted set %#x and no ambient set", got, err, c.Permitted)
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Process creation with clone3(2).
+
+package unix
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// runtime_BeforeFork, runtime_AfterFork and runtime_AfterForkInChild
+// bracket a fork as in syscall.forkExec: signals are blocked across the
+// fork, and in the child the signal handlers are reset and the stack guard
+// is left spoiled, so that any attempt to grow the stack crashes instead of
+// running the scheduler or the garbage collector with a single thread.
+
+//go:linkname runtime_BeforeFork syscall.runtime_BeforeFork
+func runtime_BeforeFork()
+
+//go:linkname runtime_AfterFork syscall.runtime_AfterFork
+func runtime_AfterFork()
+
+//go:linkname runtime_AfterForkInChild syscall.runtime_AfterForkInChild
+func runtime_AfterForkInChild()
+
+// Clone3 creates a child process with clone3(2) as described by args, and
+// returns the child's process ID in the parent and 0 in the child. With
+// CLONE_PIDFD, it also returns a pidfd for the child in the parent, and -1
+// otherwise; args.Pidfd is ignored. With CLONE_INTO_CGROUP, the child
+// starts in the cgroup referred to by the file descriptor args.Cgroup.
+// If setTID is not empty, the child gets its process IDs from it, one per
+// nested PID namespace starting with the innermost, in place of
+// args.Set_tid and args.Set_tid_size; this requires CAP_SYS_ADMIN in the
+// user namespaces owning those PID namespaces. Exit_signal is usually SIGCHLD, so that the child can be reaped with
+// Wait4. The size of CloneArgs is passed along, so fields left zero are
+// accepted by kernels that predate them (Linux >= 5.3).
+//
+// The child is a copy of the calling thread only, without the other threads
+// of the Go runtime, and starts with the default signal handlers. Until it
+// calls Exec or exits it must only run nosplit code making raw system
+// calls, with RawSyscall, and must not allocate memory; growing the stack
+// crashes it. To start programs, os/exec with the PidFD and CgroupFD fields
+// of syscall.SysProcAttr is usually preferable. CLONE_VM, CLONE_THREAD and
+// CLONE_SETTLS, which would replace the thread-local storage of the Go
+// runtime in the child, are not supported and return EINVAL.
+//
+//go:nosplit
+func Clone3(args *CloneArgs, setTID []int32) (pid, pidfd int, err error) {
+	if args.Flags&(CLONE_VM|CLONE_THREAD|CLONE_SETTLS) != 0 {
+		return -1, -1, EINVAL
+	}
+	a := *args
+	var fd *int32
+	if a.Flags&CLONE_PIDFD != 0 {
+		fd = new(int32)
+		a.Pidfd = kernelAddr(unsafe.Pointer(fd))
+	}
+	a.Set_tid = kernelAddr(unsafe.Pointer(unsafe.SliceData(setTID)))
+	a.Set_tid_size = uint64(len(setTID))
+	r, e := clone3(&a)
+	runtime.KeepAlive(setTID)
+	if r == 0 && e == 0 {
+		return 0, -1, nil
+	}
+	if e != 0 {
+		return -1, -1, e
+	}
+	pidfd = -1
+	if fd != nil {
+		pidfd = int(*fd)
+	}
+	return int(r), pidfd, nil
+}
+
+// clone3 makes the clone3 system call between the fork hooks. In the child
+// it returns 0.
+//
+//go:nosplit
+func clone3(args *CloneArgs) (uintptr, Errno) {
+	runtime_BeforeFork()
+	r, _, e := RawSyscall(SYS_CLONE3, uintptr(unsafe.Pointer(args)), unsafe.Sizeof(*args), 0)
+	if e == 0 && r == 0 {
+		runtime_AfterForkInChild()
+		return 0, 0
+	}
+	runtime_AfterFork()
+	return r, e
+}
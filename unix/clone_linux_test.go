@@ -0,0 +1,84 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestClone3(t *testing.T) {
+	if _, _, err := unix.Clone3(&unix.CloneArgs{Flags: unix.CLONE_VM}, nil); err != unix.EINVAL {
+		t.Errorf("Clone3 with CLONE_VM: got %v, want EINVAL", err)
+	}
+	if _, _, err := unix.Clone3(&unix.CloneArgs{Flags: unix.CLONE_SETTLS}, nil); err != unix.EINVAL {
+		t.Errorf("Clone3 with CLONE_SETTLS: got %v, want EINVAL", err)
+	}
+
+	pid, pidfd, err := unix.Clone3(&unix.CloneArgs{
+		Flags:       unix.CLONE_PIDFD,
+		Exit_signal: uint64(unix.SIGCHLD),
+	}, nil)
+	if pid == 0 {
+		// Child: only raw system calls are safe here.
+		unix.RawSyscall(unix.SYS_EXIT_GROUP, 7, 0, 0)
+	}
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("clone3 not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("Clone3: %v", err)
+	}
+	if pidfd < 0 {
+		t.Fatalf("Clone3 with CLONE_PIDFD returned pidfd %d", pidfd)
+	}
+	defer unix.Close(pidfd)
+
+	exited, err := unix.WaitPidfd(pidfd, 10*time.Second)
+	if err != nil || !exited {
+		t.Errorf("WaitPidfd: exited %v, error %v", exited, err)
+	}
+	var ws unix.WaitStatus
+	if _, err := unix.Wait4(pid, &ws, 0, nil); err != nil {
+		t.Fatalf("Wait4: %v", err)
+	}
+	if !ws.Exited() || ws.ExitStatus() != 7 {
+		t.Errorf("child wait status %#x, want exit status 7", ws)
+	}
+}
+
+func TestClone3SetTID(t *testing.T) {
+	// The child is process 1 of a new PID namespace.
+	pid, _, err := unix.Clone3(&unix.CloneArgs{
+		Flags:       unix.CLONE_NEWPID,
+		Exit_signal: uint64(unix.SIGCHLD),
+	}, []int32{1})
+	if pid == 0 {
+		// Child: only raw system calls are safe here.
+		p, _, _ := unix.RawSyscall(unix.SYS_GETPID, 0, 0, 0)
+		code := uintptr(1)
+		if p == 1 {
+			code = 7
+		}
+		unix.RawSyscall(unix.SYS_EXIT_GROUP, code, 0, 0)
+	}
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("clone3 with set_tid not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("Clone3: %v", err)
+	}
+	var ws unix.WaitStatus
+	if _, err := unix.Wait4(pid, &ws, 0, nil); err != nil {
+		t.Fatalf("Wait4: %v", err)
+	}
+	if !ws.Exited() || ws.ExitStatus() != 7 {
+		t.Errorf("child wait status %#x, want exit status 7 for process ID 1", ws)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// CPU cache topology read from sysfs.
+
+package unix
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CacheInfo describes a CPU cache as listed in
+// /sys/devices/system/cpu/cpuN/cache.
+type CacheInfo struct {
+	Level         int
+	Type          string // "Data", "Instruction" or "Unified"
+	SizeBytes     uint64
+	LineSize      int
+	SharedCPUList string // CPUs sharing the cache, e.g. "0-3,8-11"
+}
+
+// CPUCaches returns the caches used by the given CPU, ordered as the kernel
+// lists them, which is usually from level 1 upwards.
+func CPUCaches(cpu int) ([]CacheInfo, error) {
+	if cpu < 0 {
+		return nil, EINVAL
+	}
+	dir := filepath.Join("/sys/devices/system/cpu", "cpu"+strconv.Itoa(cpu), "cache")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var indices []int
+	for _, e := range entries {
+		if s, ok := strings.CutPrefix(e.Name(), "index"); ok {
+			if i, err := strconv.Atoi(s); err == nil {
+				indices = append(indices, i)
+			}
+		}
+	}
+	sort.Ints(indices)
+
+	caches := make([]CacheInfo, 0, len(indices))
+	for _, i := range indices {
+		idx := filepath.Join(dir, "index"+strconv.Itoa(i))
+		var c CacheInfo
+		level, err := readSysfsUint(filepath.Join(idx, "level"))
+		if err != nil {
+			return nil, err
+		}
+		c.Level = int(level)
+		if c.Type, err = readSysfsString(filepath.Join(idx, "type")); err != nil {
+			return nil, err
+		}
+		// Some attributes are missing for caches the firmware does
+		// not describe fully; leave those fields zero.
+		if s, err := readSysfsString(filepath.Join(idx, "size")); err == nil {
+			if c.SizeBytes, err = parseCacheSize(s); err != nil {
+				return nil, err
+			}
+		}
+		if v, err := readSysfsUint(filepath.Join(idx, "coherency_line_size")); err == nil {
+			c.LineSize = int(v)
+		}
+		if s, err := readSysfsString(filepath.Join(idx, "shared_cpu_list")); err == nil {
+			c.SharedCPUList = s
+		}
+		caches = append(caches, c)
+	}
+	return caches, nil
+}
+
+// parseCacheSize parses a cache size such as "32K" as reported by sysfs.
+func parseCacheSize(s string) (uint64, error) {
+	shift := 0
+	switch {
+	case strings.HasSuffix(s, "K"):
+		shift = 10
+	case strings.HasSuffix(s, "M"):
+		shift = 20
+	case strings.HasSuffix(s, "G"):
+		shift = 30
+	}
+	if shift != 0 {
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return n << shift, nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestCPUCaches(t *testing.T) {
+	caches, err := unix.CPUCaches(0)
+	if os.IsNotExist(err) {
+		t.Skip("cache topology not available in sysfs")
+	}
+	if err != nil {
+		t.Fatalf("CPUCaches(0): %v", err)
+	}
+	if len(caches) == 0 {
+		t.Skip("no caches listed for CPU 0")
+	}
+	var l1 bool
+	for _, c := range caches {
+		t.Logf("L%d %s: %d bytes, %d byte lines, shared with %s", c.Level, c.Type, c.SizeBytes, c.LineSize, c.SharedCPUList)
+		if c.Level == 1 && c.LineSize > 0 {
+			l1 = true
+		}
+	}
+	if !l1 {
+		t.Errorf("no L1 cache with a positive line size in %+v", caches)
+	}
+
+	if _, err := unix.CPUCaches(-1); err != unix.EINVAL {
+		t.Errorf("CPUCaches(-1): got error %v, want EINVAL", err)
+	}
+}
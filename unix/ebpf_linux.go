@@ -0,0 +1,181 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// eBPF maps, programs and links with the bpf(2) system call.
+
+package unix
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// The file descriptors returned by the BPF functions are always
+// close-on-exec. Keys and values are passed as byte slices whose lengths
+// must match the key and value sizes of the map; the kernel does not know
+// their lengths.
+
+// BPFMapCreate creates a map as described by attr and returns its file
+// descriptor.
+func BPFMapCreate(attr *BPFMapCreateAttr) (int, error) {
+	return bpf(BPF_MAP_CREATE, unsafe.Pointer(attr), unsafe.Sizeof(*attr))
+}
+
+// BPFMapLookupElem copies the value of the element with the given key of
+// the map mapFd into value. It returns ENOENT if there is no such element.
+// The only flag is BPF_F_LOCK.
+func BPFMapLookupElem(mapFd int, key, value []byte, flags uint64) error {
+	return bpfMapElem(BPF_MAP_LOOKUP_ELEM, mapFd, key, value, flags)
+}
+
+// BPFMapUpdateElem sets the value of the element with the given key of the
+// map mapFd. With BPF_NOEXIST in flags the element must not exist yet, with
+// BPF_EXIST it must already exist, and with BPF_ANY either is fine.
+func BPFMapUpdateElem(mapFd int, key, value []byte, flags uint64) error {
+	return bpfMapElem(BPF_MAP_UPDATE_ELEM, mapFd, key, value, flags)
+}
+
+// BPFMapDeleteElem deletes the element with the given key of the map mapFd.
+func BPFMapDeleteElem(mapFd int, key []byte) error {
+	return bpfMapElem(BPF_MAP_DELETE_ELEM, mapFd, key, nil, 0)
+}
+
+// BPFMapGetNextKey copies into nextKey the key following key in the map
+// mapFd, or the first key if key is nil. It returns ENOENT after the last
+// key.
+func BPFMapGetNextKey(mapFd int, key, nextKey []byte) error {
+	return bpfMapElem(BPF_MAP_GET_NEXT_KEY, mapFd, key, nextKey, 0)
+}
+
+func bpfMapElem(cmd int, mapFd int, key, value []byte, flags uint64) error {
+	attr := BPFMapElemAttr{
+		Map_fd: uint32(mapFd),
+		Key:    kernelAddr(unsafe.Pointer(unsafe.SliceData(key))),
+		Value:  kernelAddr(unsafe.Pointer(unsafe.SliceData(value))),
+		Flags:  flags,
+	}
+	_, err := bpf(cmd, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(key)
+	runtime.KeepAlive(value)
+	return err
+}
+
+// BPFMapLookupBatch copies up to attr.Count elements of the map
+// attr.Map_fd, starting after the position in inBatch or from the start if
+// inBatch is nil, into keys and values, which must have room for
+// attr.Count elements. On return attr.Count holds the number of elements
+// copied and outBatch the position to pass as inBatch to continue; the
+// last batch fails with ENOENT. Both batch buffers must be the size of a
+// key.
+func BPFMapLookupBatch(attr *BPFMapBatchAttr, inBatch, outBatch, keys, values []byte) error {
+	return bpfMapBatch(BPF_MAP_LOOKUP_BATCH, attr, inBatch, outBatch, keys, values)
+}
+
+// BPFMapLookupAndDeleteBatch is like BPFMapLookupBatch, and also deletes
+// the elements copied.
+func BPFMapLookupAndDeleteBatch(attr *BPFMapBatchAttr, inBatch, outBatch, keys, values []byte) error {
+	return bpfMapBatch(BPF_MAP_LOOKUP_AND_DELETE_BATCH, attr, inBatch, outBatch, keys, values)
+}
+
+// BPFMapUpdateBatch sets the values of attr.Count elements of the map
+// attr.Map_fd, with the keys in keys and the values in values, according
+// to attr.Elem_flags as in BPFMapUpdateElem. On return attr.Count holds the
+// number of elements updated.
+func BPFMapUpdateBatch(attr *BPFMapBatchAttr, keys, values []byte) error {
+	return bpfMapBatch(BPF_MAP_UPDATE_BATCH, attr, nil, nil, keys, values)
+}
+
+// BPFMapDeleteBatch deletes attr.Count elements of the map attr.Map_fd with
+// the keys in keys. On return attr.Count holds the number of elements
+// deleted.
+func BPFMapDeleteBatch(attr *BPFMapBatchAttr, keys []byte) error {
+	return bpfMapBatch(BPF_MAP_DELETE_BATCH, attr, nil, nil, keys, nil)
+}
+
+func bpfMapBatch(cmd int, attr *BPFMapBatchAttr, inBatch, outBatch, keys, values []byte) error {
+	attr.In_batch = kernelAddr(unsafe.Pointer(unsafe.SliceData(inBatch)))
+	attr.Out_batch = kernelAddr(unsafe.Pointer(unsafe.SliceData(outBatch)))
+	attr.Keys = kernelAddr(unsafe.Pointer(unsafe.SliceData(keys)))
+	attr.Values = kernelAddr(unsafe.Pointer(unsafe.SliceData(values)))
+	_, err := bpf(cmd, unsafe.Pointer(attr), unsafe.Sizeof(*attr))
+	attr.In_batch, attr.Out_batch, attr.Keys, attr.Values = 0, 0, 0, 0
+	runtime.KeepAlive(inBatch)
+	runtime.KeepAlive(outBatch)
+	runtime.KeepAlive(keys)
+	runtime.KeepAlive(values)
+	return err
+}
+
+// BPFProgLoad loads the program insns under the given license and returns
+// its file descriptor. Programs using GPL-only helpers need a
+// GPL-compatible license such as "GPL". The other fields of attr, Prog_type
+// first, describe the program. If log is not empty, the verifier writes its
+// log into it, at level 1 unless attr.Log_level is set.
+func BPFProgLoad(attr *BPFProgLoadAttr, insns []BPFInsn, license string, log []byte) (int, error) {
+	lic, err := BytePtrFromString(license)
+	if err != nil {
+		return -1, err
+	}
+	a := *attr
+	a.Insns = kernelAddr(unsafe.Pointer(unsafe.SliceData(insns)))
+	a.Insn_cnt = uint32(len(insns))
+	a.License = kernelAddr(unsafe.Pointer(lic))
+	if len(log) > 0 {
+		a.Log_buf = kernelAddr(unsafe.Pointer(unsafe.SliceData(log)))
+		a.Log_size = uint32(len(log))
+		if a.Log_level == 0 {
+			a.Log_level = 1
+		}
+	}
+	fd, err := bpf(BPF_PROG_LOAD, unsafe.Pointer(&a), unsafe.Sizeof(a))
+	runtime.KeepAlive(insns)
+	runtime.KeepAlive(lic)
+	runtime.KeepAlive(log)
+	return fd, err
+}
+
+// BPFObjPin pins the map, program or link fd at path in a BPF file system,
+// which keeps it alive after the file descriptor is closed.
+func BPFObjPin(fd int, path string) error {
+	p, err := BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+	attr := BPFObjAttr{Pathname: kernelAddr(unsafe.Pointer(p)), Bpf_fd: uint32(fd)}
+	_, err = bpf(BPF_OBJ_PIN, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(p)
+	return err
+}
+
+// BPFObjGet returns a new file descriptor for the object pinned at path.
+// The flags BPF_F_RDONLY and BPF_F_WRONLY restrict the access to a map.
+func BPFObjGet(path string, flags uint32) (int, error) {
+	p, err := BytePtrFromString(path)
+	if err != nil {
+		return -1, err
+	}
+	attr := BPFObjAttr{Pathname: kernelAddr(unsafe.Pointer(p)), File_flags: flags}
+	fd, err := bpf(BPF_OBJ_GET, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(p)
+	return fd, err
+}
+
+// BPFLinkCreate attaches the program attr.Prog_fd to attr.Target_fd, such
+// as a cgroup directory or a network interface index, for the BPF_*
+// attach type attr.Attach_type. It returns the file descriptor of the
+// link: the program stays attached until the link is closed, unless it
+// has been pinned.
+func BPFLinkCreate(attr *BPFLinkCreateAttr) (int, error) {
+	return bpf(BPF_LINK_CREATE, unsafe.Pointer(attr), unsafe.Sizeof(*attr))
+}
+
+// SetRegs sets the destination and source registers of the instruction,
+// BPF_REG_0 to BPF_REG_10.
+func (insn *BPFInsn) SetRegs(dst, src uint8) {
+	if isBigEndian {
+		insn.Regs = dst<<4 | src&0xf
+	} else {
+		insn.Regs = src<<4 | dst&0xf
+	}
+}
@@ -0,0 +1,295 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// bpfHashMap creates a hash map with 4-byte keys and 8-byte values,
+// skipping the test if eBPF is not available.
+func bpfHashMap(t *testing.T, maxEntries uint32) int {
+	t.Helper()
+	fd, err := unix.BPFMapCreate(&unix.BPFMapCreateAttr{
+		Map_type:    unix.BPF_MAP_TYPE_HASH,
+		Key_size:    4,
+		Value_size:  8,
+		Max_entries: maxEntries,
+	})
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("eBPF not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("BPFMapCreate: %v", err)
+	}
+	t.Cleanup(func() { unix.Close(fd) })
+	return fd
+}
+
+// bpfReturn returns a program that returns v.
+func bpfReturn(v int32) []unix.BPFInsn {
+	mov := unix.BPFInsn{Code: unix.BPF_ALU64 | unix.BPF_MOV | unix.BPF_K, Imm: v}
+	mov.SetRegs(unix.BPF_REG_0, 0)
+	return []unix.BPFInsn{mov, {Code: unix.BPF_JMP | unix.BPF_EXIT}}
+}
+
+// bpfProgLoad loads insns as a program of the given type, skipping the
+// test if eBPF is not available.
+func bpfProgLoad(t *testing.T, progType uint32, insns []unix.BPFInsn) int {
+	t.Helper()
+	log := make([]byte, 4096)
+	fd, err := unix.BPFProgLoad(&unix.BPFProgLoadAttr{Prog_type: progType}, insns, "GPL", log)
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("eBPF not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("BPFProgLoad: %v\n%s", err, unix.ByteSliceToString(log))
+	}
+	t.Cleanup(func() { unix.Close(fd) })
+	return fd
+}
+
+func TestBPFTypes(t *testing.T) {
+	if got := unsafe.Sizeof(unix.BPFInsn{}); got != unix.SizeofBPFInsn {
+		t.Errorf("sizeof BPFInsn: got %d, want %d", got, unix.SizeofBPFInsn)
+	}
+	var insn unix.BPFInsn
+	insn.SetRegs(unix.BPF_REG_1, unix.BPF_REG_10)
+	b := (*[unix.SizeofBPFInsn]byte)(unsafe.Pointer(&insn))
+	// The register nibbles are laid out like C bit fields.
+	want := byte(unix.BPF_REG_10<<4 | unix.BPF_REG_1)
+	if binary.NativeEndian.Uint16([]byte{0, 1}) == 1 {
+		want = byte(unix.BPF_REG_1<<4 | unix.BPF_REG_10)
+	}
+	if b[1] != want {
+		t.Errorf("SetRegs(1, 10): got register byte %#x, want %#x", b[1], want)
+	}
+}
+
+func TestBPFMapElem(t *testing.T) {
+	fd := bpfHashMap(t, 16)
+
+	key := func(k uint32) []byte { return binary.NativeEndian.AppendUint32(nil, k) }
+	value := func(v uint64) []byte { return binary.NativeEndian.AppendUint64(nil, v) }
+	for k := uint32(1); k <= 3; k++ {
+		if err := unix.BPFMapUpdateElem(fd, key(k), value(uint64(k)*10), unix.BPF_NOEXIST); err != nil {
+			t.Fatalf("BPFMapUpdateElem(%d): %v", k, err)
+		}
+	}
+	if err := unix.BPFMapUpdateElem(fd, key(1), value(0), unix.BPF_NOEXIST); err != unix.EEXIST {
+		t.Errorf("BPFMapUpdateElem of an existing key with BPF_NOEXIST: got %v, want EEXIST", err)
+	}
+
+	v := make([]byte, 8)
+	if err := unix.BPFMapLookupElem(fd, key(2), v, 0); err != nil {
+		t.Fatalf("BPFMapLookupElem: %v", err)
+	}
+	if got := binary.NativeEndian.Uint64(v); got != 20 {
+		t.Errorf("BPFMapLookupElem: got %d, want 20", got)
+	}
+
+	if err := unix.BPFMapDeleteElem(fd, key(2)); err != nil {
+		t.Fatalf("BPFMapDeleteElem: %v", err)
+	}
+	if err := unix.BPFMapLookupElem(fd, key(2), v, 0); err != unix.ENOENT {
+		t.Errorf("BPFMapLookupElem of a deleted key: got %v, want ENOENT", err)
+	}
+
+	var keys []uint32
+	var k []byte
+	next := make([]byte, 4)
+	for {
+		err := unix.BPFMapGetNextKey(fd, k, next)
+		if err == unix.ENOENT {
+			break
+		}
+		if err != nil {
+			t.Fatalf("BPFMapGetNextKey: %v", err)
+		}
+		keys = append(keys, binary.NativeEndian.Uint32(next))
+		k = append(k[:0], next...)
+	}
+	if len(keys) != 2 || keys[0]+keys[1] != 4 {
+		t.Errorf("BPFMapGetNextKey: got keys %v, want 1 and 3", keys)
+	}
+}
+
+func TestBPFMapBatch(t *testing.T) {
+	fd := bpfHashMap(t, 16)
+
+	const n = 8
+	keys := make([]byte, 4*n)
+	values := make([]byte, 8*n)
+	for i := 0; i < n; i++ {
+		binary.NativeEndian.PutUint32(keys[4*i:], uint32(i))
+		binary.NativeEndian.PutUint64(values[8*i:], uint64(i)*100)
+	}
+	attr := unix.BPFMapBatchAttr{Map_fd: uint32(fd), Count: n}
+	if err := unix.BPFMapUpdateBatch(&attr, keys, values); err == unix.EINVAL {
+		t.Skipf("batch operations not supported: %v", err)
+	} else if err != nil {
+		t.Fatalf("BPFMapUpdateBatch: %v", err)
+	}
+	if attr.Count != n {
+		t.Errorf("BPFMapUpdateBatch: updated %d elements, want %d", attr.Count, n)
+	}
+
+	// Read the map back in batches of 3.
+	got := make(map[uint32]uint64)
+	var inBatch []byte
+	outBatch := make([]byte, 4)
+	for {
+		keys := make([]byte, 4*3)
+		values := make([]byte, 8*3)
+		attr := unix.BPFMapBatchAttr{Map_fd: uint32(fd), Count: 3}
+		err := unix.BPFMapLookupBatch(&attr, inBatch, outBatch, keys, values)
+		if err != nil && err != unix.ENOENT {
+			t.Fatalf("BPFMapLookupBatch: %v", err)
+		}
+		for i := 0; i < int(attr.Count); i++ {
+			got[binary.NativeEndian.Uint32(keys[4*i:])] = binary.NativeEndian.Uint64(values[8*i:])
+		}
+		if err == unix.ENOENT {
+			break
+		}
+		inBatch = append(inBatch[:0], outBatch...)
+	}
+	if len(got) != n {
+		t.Errorf("BPFMapLookupBatch: got %d elements, want %d", len(got), n)
+	}
+	for k, v := range got {
+		if v != uint64(k)*100 {
+			t.Errorf("BPFMapLookupBatch: element %d = %d, want %d", k, v, k*100)
+		}
+	}
+
+	attr = unix.BPFMapBatchAttr{Map_fd: uint32(fd), Count: n}
+	if err := unix.BPFMapDeleteBatch(&attr, keys); err != nil {
+		t.Fatalf("BPFMapDeleteBatch: %v", err)
+	}
+	if err := unix.BPFMapGetNextKey(fd, nil, make([]byte, 4)); err != unix.ENOENT {
+		t.Errorf("BPFMapGetNextKey on an emptied map: got %v, want ENOENT", err)
+	}
+}
+
+func TestBPFProgLoad(t *testing.T) {
+	prog := bpfProgLoad(t, unix.BPF_PROG_TYPE_SOCKET_FILTER, bpfReturn(0))
+
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Socketpair: %v", err)
+	}
+	defer unix.Close(fds[0])
+	defer unix.Close(fds[1])
+	if err := unix.SetsockoptInt(fds[1], unix.SOL_SOCKET, unix.SO_ATTACH_BPF, prog); err != nil {
+		t.Fatalf("SO_ATTACH_BPF: %v", err)
+	}
+	if _, err := unix.Write(fds[0], []byte("dropped")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if _, _, err := unix.Recvfrom(fds[1], make([]byte, 16), unix.MSG_DONTWAIT); err != unix.EAGAIN {
+		t.Errorf("Recvfrom on a socket filtered by the program: got %v, want EAGAIN", err)
+	}
+
+	// A program that does not set the return value is rejected, with an
+	// explanation in the log.
+	log := make([]byte, 4096)
+	exit := []unix.BPFInsn{{Code: unix.BPF_JMP | unix.BPF_EXIT}}
+	fd, err := unix.BPFProgLoad(&unix.BPFProgLoadAttr{Prog_type: unix.BPF_PROG_TYPE_SOCKET_FILTER}, exit, "GPL", log)
+	if err == nil {
+		unix.Close(fd)
+		t.Fatalf("BPFProgLoad of an invalid program succeeded")
+	}
+	if msg := unix.ByteSliceToString(log); !strings.Contains(msg, "R0") {
+		t.Errorf("BPFProgLoad of an invalid program: %v, log %q does not mention R0", err, msg)
+	}
+}
+
+func TestBPFObjPin(t *testing.T) {
+	fd := bpfHashMap(t, 1)
+
+	// Mount a detached BPF file system so that nothing is left behind.
+	fsc, err := unix.NewFsContext("bpf")
+	if err != nil {
+		t.Skipf("NewFsContext: %v", err)
+	}
+	defer fsc.Close()
+	mnt, err := fsc.Mount(0)
+	if err != nil {
+		t.Skipf("mounting a BPF file system: %v", err)
+	}
+	defer unix.Close(mnt)
+	path := fmt.Sprintf("/proc/self/fd/%d/map", mnt)
+
+	if err := unix.BPFObjPin(fd, path); err != nil {
+		t.Fatalf("BPFObjPin: %v", err)
+	}
+	if err := unix.BPFMapUpdateElem(fd, make([]byte, 4), []byte("pinned!\x00"), unix.BPF_ANY); err != nil {
+		t.Fatalf("BPFMapUpdateElem: %v", err)
+	}
+	ro, err := unix.BPFObjGet(path, unix.BPF_F_RDONLY)
+	if err != nil {
+		t.Fatalf("BPFObjGet: %v", err)
+	}
+	defer unix.Close(ro)
+	v := make([]byte, 8)
+	if err := unix.BPFMapLookupElem(ro, make([]byte, 4), v, 0); err != nil || string(v) != "pinned!\x00" {
+		t.Errorf("BPFMapLookupElem through the pinned map = %q, %v", v, err)
+	}
+	if err := unix.BPFMapUpdateElem(ro, make([]byte, 4), v, unix.BPF_ANY); err != unix.EPERM {
+		t.Errorf("BPFMapUpdateElem through a read-only descriptor: got %v, want EPERM", err)
+	}
+	if _, err := unix.BPFObjGet(filepath.Join(filepath.Dir(path), "missing"), 0); err != unix.ENOENT {
+		t.Errorf("BPFObjGet of a missing path: got %v, want ENOENT", err)
+	}
+}
+
+func TestBPFLinkCreate(t *testing.T) {
+	const root = "/sys/fs/cgroup"
+	var st unix.Statfs_t
+	dir := root
+	if err := unix.Statfs(dir, &st); err == nil && st.Type != unix.CGROUP2_SUPER_MAGIC {
+		dir = filepath.Join(root, "unified")
+		if err := unix.Statfs(dir, &st); err != nil || st.Type != unix.CGROUP2_SUPER_MAGIC {
+			t.Skip("no cgroup2 hierarchy")
+		}
+	}
+	cg, err := os.MkdirTemp(dir, "go-test-")
+	if err != nil {
+		t.Skipf("creating a cgroup: %v", err)
+	}
+	defer os.Remove(cg)
+	cgfd, err := unix.Open(cg, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer unix.Close(cgfd)
+
+	prog := bpfProgLoad(t, unix.BPF_PROG_TYPE_CGROUP_SKB, bpfReturn(1))
+	link, err := unix.BPFLinkCreate(&unix.BPFLinkCreateAttr{
+		Prog_fd:     uint32(prog),
+		Target_fd:   uint32(cgfd),
+		Attach_type: unix.BPF_CGROUP_INET_INGRESS,
+	})
+	if err == unix.EINVAL {
+		t.Skipf("BPF links not supported: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("BPFLinkCreate: %v", err)
+	}
+	if err := unix.Close(link); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+}
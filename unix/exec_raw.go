@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build freebsd || linux
+
+package unix
+
+import (
+	"syscall"
+	_ "unsafe" // for go:linkname
+)
+
+// runtime_BeforeExec and runtime_AfterExec bracket exec system calls made
+// directly rather than through syscall.Exec, so that the runtime does not
+// start threads while the process image is being replaced.
+
+//go:linkname runtime_BeforeExec syscall.runtime_BeforeExec
+func runtime_BeforeExec()
+
+//go:linkname runtime_AfterExec syscall.runtime_AfterExec
+func runtime_AfterExec()
+
+// restoreRlimitNofile restores the RLIMIT_NOFILE soft limit that the Go
+// runtime raises at startup, as Exec does before executing a program. The
+// original limit is private to package syscall, so this lets Exec restore
+// it by executing an empty path, which fails with ENOENT. As with Exec,
+// the limit stays restored if the exec then fails.
+func restoreRlimitNofile() {
+	syscall.Exec("", nil, nil)
+}
+
+// execArgs converts argv and envv to the NULL-terminated arrays of C
+// strings expected by the exec system calls.
+func execArgs(argv, envv []string) (argvp, envvp []*byte, err error) {
+	if argvp, err = syscall.SlicePtrFromStrings(argv); err != nil {
+		return nil, nil, err
+	}
+	if envvp, err = syscall.SlicePtrFromStrings(envv); err != nil {
+		return nil, nil, err
+	}
+	return argvp, envvp, nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Reading fanotify events and answering permission events, see
+// fanotify(7).
+
+package unix
+
+import "unsafe"
+
+// FanotifyEvent is an event read from a fanotify group with
+// ReadFanotifyEvents, together with the information records that follow
+// its metadata.
+type FanotifyEvent struct {
+	Mask uint64 // FAN_* event flags
+	Pid  int    // process, or thread with FAN_REPORT_TID, that caused the event
+
+	// Fd is an open file descriptor for the object of the event, or
+	// FAN_NOFD for groups reporting file identifiers and for
+	// FAN_Q_OVERFLOW. Permission events are answered with it using
+	// FanotifyRespond.
+	Fd int
+
+	// Pidfd is a pidfd for Pid with FAN_REPORT_PIDFD, or FAN_NOPIDFD if
+	// the process has already exited, or FAN_EPIDFD if the pidfd could
+	// not be created. It is FAN_NOPIDFD without FAN_REPORT_PIDFD.
+	Pidfd int
+
+	// FIDs identifies the objects of the event for groups reporting file
+	// identifiers with FAN_REPORT_FID, FAN_REPORT_DIR_FID or
+	// FAN_REPORT_NAME.
+	FIDs []FanotifyFID
+
+	// Error and ErrorCount describe a FAN_FS_ERROR event: the first error
+	// and the number of errors since the last event.
+	Error      Errno
+	ErrorCount int
+}
+
+// FanotifyFID is a file identifier record of a fanotify event.
+type FanotifyFID struct {
+	Type   uint8 // FAN_EVENT_INFO_TYPE_* record type
+	Fsid   Fsid
+	Handle FileHandle // for OpenByHandleAt with a file descriptor on the filesystem
+	Name   string     // entry name for the *_DFID_NAME record types
+}
+
+// Close closes the file descriptors of the event, if any. Permission
+// events must be answered before their file descriptor is closed.
+func (ev *FanotifyEvent) Close() error {
+	var err error
+	if ev.Fd >= 0 {
+		err = Close(ev.Fd)
+		ev.Fd = FAN_NOFD
+	}
+	if ev.Pidfd >= 0 {
+		if e := Close(ev.Pidfd); err == nil {
+			err = e
+		}
+		ev.Pidfd = FAN_NOPIDFD
+	}
+	return err
+}
+
+// ReadFanotifyEvents reads pending events from the fanotify group fd using
+// buf, which should hold at least 4096 bytes as events with file
+// identifiers and names are larger than their metadata, and returns them.
+// It blocks until an event is available unless fd was created with
+// FAN_NONBLOCK, in which case it returns EAGAIN. The file descriptors of
+// the events belong to the caller, see FanotifyEvent.Close. If the events
+// cannot be parsed, it closes the file descriptors it received and returns
+// EINVAL.
+func ReadFanotifyEvents(fd int, buf []byte) ([]FanotifyEvent, error) {
+	n, err := Read(fd, buf)
+	if err != nil {
+		return nil, err
+	}
+	var events []FanotifyEvent
+	for b := buf[:n]; len(b) > 0; {
+		if len(b) < FAN_EVENT_METADATA_LEN {
+			closeFanotifyEvents(events)
+			return nil, EINVAL
+		}
+		meta := (*FanotifyEventMetadata)(unsafe.Pointer(&b[0]))
+		if meta.Vers != FANOTIFY_METADATA_VERSION ||
+			int(meta.Event_len) < int(meta.Metadata_len) ||
+			int(meta.Metadata_len) < FAN_EVENT_METADATA_LEN ||
+			int(meta.Event_len) > len(b) {
+			closeFanotifyEvents(events)
+			closeFanotifyFds(b)
+			return nil, EINVAL
+		}
+		ev := FanotifyEvent{
+			Mask:  meta.Mask,
+			Pid:   int(meta.Pid),
+			Fd:    int(meta.Fd),
+			Pidfd: FAN_NOPIDFD,
+		}
+		parseFanotifyInfo(&ev, b[meta.Metadata_len:meta.Event_len])
+		events = append(events, ev)
+		b = b[meta.Event_len:]
+	}
+	return events, nil
+}
+
+func closeFanotifyEvents(events []FanotifyEvent) {
+	for i := range events {
+		events[i].Close()
+	}
+}
+
+// closeFanotifyFds closes the file descriptors of the events in b that
+// could not be parsed, as far as their metadata can be walked. Their
+// information records, which may hold pidfds, are not trusted.
+func closeFanotifyFds(b []byte) {
+	for len(b) >= FAN_EVENT_METADATA_LEN {
+		meta := (*FanotifyEventMetadata)(unsafe.Pointer(&b[0]))
+		if meta.Fd >= 0 {
+			Close(int(meta.Fd))
+		}
+		if meta.Event_len < FAN_EVENT_METADATA_LEN || int(meta.Event_len) > len(b) {
+			return
+		}
+		b = b[meta.Event_len:]
+	}
+}
+
+// parseFanotifyInfo decodes the information records of an event.
+func parseFanotifyInfo(ev *FanotifyEvent, b []byte) {
+	const hdrLen = 4 // struct fanotify_event_info_header
+	for len(b) >= hdrLen {
+		typ := b[0]
+		size := int(*(*uint16)(unsafe.Pointer(&b[2])))
+		if size < hdrLen || size > len(b) {
+			return
+		}
+		rec := b[hdrLen:size]
+		switch typ {
+		case FAN_EVENT_INFO_TYPE_FID, FAN_EVENT_INFO_TYPE_DFID,
+			FAN_EVENT_INFO_TYPE_DFID_NAME, FAN_EVENT_INFO_TYPE_OLD_DFID_NAME,
+			FAN_EVENT_INFO_TYPE_NEW_DFID_NAME:
+			// fsid, then a struct file_handle, then for the name
+			// records a NUL-terminated name.
+			const fhOff = int(unsafe.Sizeof(Fsid{}))
+			const fhLen = int(unsafe.Sizeof(fileHandle{}))
+			if len(rec) < fhOff+fhLen {
+				break
+			}
+			fh := (*fileHandle)(unsafe.Pointer(&rec[fhOff]))
+			end := fhOff + fhLen + int(fh.Bytes)
+			if end > len(rec) {
+				break
+			}
+			fid := FanotifyFID{
+				Type:   typ,
+				Fsid:   *(*Fsid)(unsafe.Pointer(&rec[0])),
+				Handle: NewFileHandle(fh.Type, rec[fhOff+fhLen:end]),
+			}
+			if typ != FAN_EVENT_INFO_TYPE_FID && typ != FAN_EVENT_INFO_TYPE_DFID {
+				fid.Name = ByteSliceToString(rec[end:])
+			}
+			ev.FIDs = append(ev.FIDs, fid)
+		case FAN_EVENT_INFO_TYPE_PIDFD:
+			if len(rec) >= 4 {
+				ev.Pidfd = int(*(*int32)(unsafe.Pointer(&rec[0])))
+			}
+		case FAN_EVENT_INFO_TYPE_ERROR:
+			if len(rec) >= 8 {
+				ev.Error = Errno(*(*int32)(unsafe.Pointer(&rec[0])))
+				ev.ErrorCount = int(*(*uint32)(unsafe.Pointer(&rec[4])))
+			}
+		}
+		b = b[size:]
+	}
+}
+
+// FanotifyRespond answers the permission event with file descriptor
+// eventFd, read from the group fd, with FAN_ALLOW or FAN_DENY, optionally
+// with FAN_AUDIT. The event's file descriptor is not closed.
+func FanotifyRespond(fd int, eventFd int, response uint32) error {
+	resp := FanotifyResponse{Fd: int32(eventFd), Response: response}
+	_, err := Write(fd, (*[unsafe.Sizeof(resp)]byte)(unsafe.Pointer(&resp))[:])
+	return err
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"unsafe"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// fanotifyInit creates a fanotify group, skipping the test if fanotify or
+// the requested flags are not available.
+func fanotifyInit(t *testing.T, flags uint) int {
+	t.Helper()
+	fd, err := unix.FanotifyInit(flags|unix.FAN_CLOEXEC, unix.O_RDONLY|unix.O_CLOEXEC)
+	if err == unix.ENOSYS || err == unix.EPERM || err == unix.EINVAL {
+		t.Skipf("fanotify not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("FanotifyInit: %v", err)
+	}
+	t.Cleanup(func() { unix.Close(fd) })
+	return fd
+}
+
+// readFanotifyEvent reads events from fd until one has the given mask
+// bits.
+func readFanotifyEvent(t *testing.T, fd int, mask uint64) unix.FanotifyEvent {
+	t.Helper()
+	buf := make([]byte, 4096)
+	for {
+		events, err := unix.ReadFanotifyEvents(fd, buf)
+		if err != nil {
+			t.Fatalf("ReadFanotifyEvents: %v", err)
+		}
+		for i, ev := range events {
+			if ev.Mask&mask != 0 {
+				for _, other := range events[i+1:] {
+					other.Close()
+				}
+				return ev
+			}
+			ev.Close()
+		}
+	}
+}
+
+func TestFanotifyEvents(t *testing.T) {
+	fd := fanotifyInit(t, unix.FAN_CLASS_NOTIF)
+	dir := t.TempDir()
+	if err := unix.FanotifyMark(fd, unix.FAN_MARK_ADD, unix.FAN_CLOSE_WRITE|unix.FAN_EVENT_ON_CHILD, unix.AT_FDCWD, dir); err != nil {
+		t.Fatalf("FanotifyMark: %v", err)
+	}
+	file := filepath.Join(dir, "file")
+	if err := os.WriteFile(file, []byte("data"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ev := readFanotifyEvent(t, fd, unix.FAN_CLOSE_WRITE)
+	defer ev.Close()
+	if ev.Pid != os.Getpid() {
+		t.Errorf("event pid: got %d, want %d", ev.Pid, os.Getpid())
+	}
+	if ev.Fd < 0 {
+		t.Fatalf("event fd: got %d", ev.Fd)
+	}
+	if path, err := os.Readlink(fmt.Sprintf("/proc/self/fd/%d", ev.Fd)); err != nil || path != file {
+		t.Errorf("event file = %q, %v; want %q", path, err, file)
+	}
+	if ev.Pidfd != unix.FAN_NOPIDFD || len(ev.FIDs) != 0 {
+		t.Errorf("unexpected information records: pidfd %d, FIDs %v", ev.Pidfd, ev.FIDs)
+	}
+}
+
+func TestFanotifyFID(t *testing.T) {
+	fd := fanotifyInit(t, unix.FAN_CLASS_NOTIF|unix.FAN_REPORT_DFID_NAME|unix.FAN_REPORT_PIDFD)
+	dir := t.TempDir()
+	if err := unix.FanotifyMark(fd, unix.FAN_MARK_ADD, unix.FAN_CREATE|unix.FAN_ONDIR, unix.AT_FDCWD, dir); err != nil {
+		t.Fatalf("FanotifyMark: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "file"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ev := readFanotifyEvent(t, fd, unix.FAN_CREATE)
+	defer ev.Close()
+	if ev.Fd != unix.FAN_NOFD {
+		t.Errorf("event fd with FID reporting: got %d, want FAN_NOFD", ev.Fd)
+	}
+	if ev.Pidfd < 0 {
+		t.Errorf("event pidfd: got %d", ev.Pidfd)
+	} else if err := unix.PidfdSendSignal(ev.Pidfd, 0, nil, 0); err != nil {
+		t.Errorf("PidfdSendSignal on the event pidfd: %v", err)
+	}
+	if len(ev.FIDs) != 1 {
+		t.Fatalf("event FIDs: got %d records, want 1", len(ev.FIDs))
+	}
+	fid := ev.FIDs[0]
+	if fid.Type != unix.FAN_EVENT_INFO_TYPE_DFID_NAME || fid.Name != "file" {
+		t.Errorf("event FID: got type %d, name %q", fid.Type, fid.Name)
+	}
+
+	// The handle identifies the parent directory.
+	mountFd, err := unix.Open(dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(mountFd)
+	dirFd, err := unix.OpenByHandleAt(mountFd, fid.Handle, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC)
+	if err != nil {
+		t.Fatalf("OpenByHandleAt: %v", err)
+	}
+	defer unix.Close(dirFd)
+	if path, err := os.Readlink(fmt.Sprintf("/proc/self/fd/%d", dirFd)); err != nil || path != dir {
+		t.Errorf("directory of the handle = %q, %v; want %q", path, err, dir)
+	}
+}
+
+func TestFanotifyRespond(t *testing.T) {
+	fd := fanotifyInit(t, unix.FAN_CLASS_CONTENT)
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := unix.FanotifyMark(fd, unix.FAN_MARK_ADD, unix.FAN_OPEN_PERM, unix.AT_FDCWD, file); err != nil {
+		t.Fatalf("FanotifyMark: %v", err)
+	}
+
+	for _, response := range []uint32{unix.FAN_DENY, unix.FAN_ALLOW} {
+		// The open blocks in the kernel until the response is written.
+		errc := make(chan error, 1)
+		go func() {
+			fd, err := unix.Open(file, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+			if err == nil {
+				unix.Close(fd)
+			}
+			errc <- err
+		}()
+		ev := readFanotifyEvent(t, fd, unix.FAN_OPEN_PERM)
+		if err := unix.FanotifyRespond(fd, ev.Fd, response); err != nil {
+			t.Fatalf("FanotifyRespond: %v", err)
+		}
+		ev.Close()
+
+		err := <-errc
+		if response == unix.FAN_DENY && err != unix.EPERM {
+			t.Errorf("Open denied by the listener: got %v, want EPERM", err)
+		}
+		if response == unix.FAN_ALLOW && err != nil {
+			t.Errorf("Open allowed by the listener: %v", err)
+		}
+	}
+}
+
+func TestReadFanotifyEventsMalformed(t *testing.T) {
+	// A well-formed event followed by one whose length overruns the buffer,
+	// each with a file descriptor that must not leak.
+	var p [2]int
+	if err := unix.Pipe2(p[:], unix.O_CLOEXEC); err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+	var fds [2]int
+	var b []byte
+	for i := range fds {
+		fd, err := unix.Dup(p[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		fds[i] = fd
+		meta := unix.FanotifyEventMetadata{
+			Event_len:    unix.FAN_EVENT_METADATA_LEN,
+			Vers:         unix.FANOTIFY_METADATA_VERSION,
+			Metadata_len: unix.FAN_EVENT_METADATA_LEN,
+			Mask:         unix.FAN_CLOSE_WRITE,
+			Fd:           int32(fd),
+			Pid:          int32(os.Getpid()),
+		}
+		if i == 1 {
+			meta.Event_len *= 2
+		}
+		b = append(b, unsafe.Slice((*byte)(unsafe.Pointer(&meta)), unsafe.Sizeof(meta))...)
+	}
+	if _, err := unix.Write(p[1], b); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := unix.ReadFanotifyEvents(p[0], make([]byte, 4096))
+	if err != unix.EINVAL || events != nil {
+		t.Errorf("ReadFanotifyEvents: got %d events, %v; want EINVAL", len(events), err)
+	}
+	for _, fd := range fds {
+		if _, err := unix.FcntlInt(uintptr(fd), unix.F_GETFD, 0); err != unix.EBADF {
+			t.Errorf("event fd %d not closed: F_GETFD returned %v", fd, err)
+		}
+	}
+}
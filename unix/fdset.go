@@ -27,3 +27,19 @@ func (fds *FdSet) Zero() {
 		fds.Bits[i] = 0
 	}
 }
+
+// FDSet adds fd to set. It is the function form of FdSet.Set, after the
+// C FD_SET macro.
+func FDSet(fd int, set *FdSet) { set.Set(fd) }
+
+// FDClr removes fd from set. It is the function form of FdSet.Clear, after
+// the C FD_CLR macro.
+func FDClr(fd int, set *FdSet) { set.Clear(fd) }
+
+// FDIsSet reports whether fd is in set. It is the function form of
+// FdSet.IsSet, after the C FD_ISSET macro.
+func FDIsSet(fd int, set *FdSet) bool { return set.IsSet(fd) }
+
+// FDZero clears set. It is the function form of FdSet.Zero, after the C
+// FD_ZERO macro.
+func FDZero(set *FdSet) { set.Zero() }
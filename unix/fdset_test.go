@@ -61,3 +61,20 @@ func TestFdSet(t *testing.T) {
 		}
 	}
 }
+
+func TestFdSetFuncs(t *testing.T) {
+	var fdSet unix.FdSet
+	unix.FDSet(3, &fdSet)
+	unix.FDSet(unix.FD_SETSIZE-1, &fdSet)
+	if !unix.FDIsSet(3, &fdSet) || !fdSet.IsSet(unix.FD_SETSIZE-1) {
+		t.Fatal("FDSet did not add fds")
+	}
+	unix.FDClr(3, &fdSet)
+	if unix.FDIsSet(3, &fdSet) || !unix.FDIsSet(unix.FD_SETSIZE-1, &fdSet) {
+		t.Fatal("FDClr(3) did not remove exactly fd 3")
+	}
+	unix.FDZero(&fdSet)
+	if unix.FDIsSet(unix.FD_SETSIZE-1, &fdSet) {
+		t.Fatal("FDZero did not clear the set")
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Run-time detection of optional system calls.
+
+package unix
+
+import (
+	"strconv"
+	"sync"
+)
+
+// syscallProbeArgs lists, for the system calls SyscallSupported can probe,
+// arguments that are known to make the call return at once without side
+// effects.
+var syscallProbeArgs = map[uintptr][6]uintptr{
+	// getpid() takes no arguments and always succeeds.
+	SYS_GETPID: {},
+	// copy_file_range(-1, NULL, -1, NULL, 0, 0) fails with EBADF.
+	SYS_COPY_FILE_RANGE: {^uintptr(0), 0, ^uintptr(0), 0, 0, 0},
+	// statx(-1, NULL, 0, 0, NULL) fails with EFAULT or EBADF.
+	SYS_STATX: {^uintptr(0), 0, 0, 0, 0, 0},
+	// openat2(-1, NULL, NULL, 0) fails with EINVAL.
+	SYS_OPENAT2: {^uintptr(0), 0, 0, 0, 0, 0},
+	// close_range(~0U, 0, 0) fails with EINVAL since first > last.
+	SYS_CLOSE_RANGE: {^uintptr(0), 0, 0, 0, 0, 0},
+	// fsopen(NULL, 0) fails with EFAULT or EPERM.
+	SYS_FSOPEN: {0, 0, 0, 0, 0, 0},
+}
+
+var syscallSupported sync.Map // uintptr -> bool
+
+// syscallProbe makes the probing system call. It is a variable so that
+// tests can count the calls.
+var syscallProbe = func(trap uintptr, args [6]uintptr) Errno {
+	_, _, errno := RawSyscall6(trap, args[0], args[1], args[2], args[3], args[4], args[5])
+	return errno
+}
+
+// SyscallSupported reports whether the kernel implements the system call
+// trap. The answer is determined by making the call once with arguments
+// known to be harmless and checking whether it fails with ENOSYS, and is
+// then cached.
+//
+// Only SYS_GETPID and the system calls of HasCopyFileRange, HasStatx,
+// HasOpenat2, HasCloseRange and HasFsopen can be probed; SyscallSupported
+// panics for any other trap, since calling it could have side effects.
+func SyscallSupported(trap uintptr) bool {
+	if v, ok := syscallSupported.Load(trap); ok {
+		return v.(bool)
+	}
+	args, ok := syscallProbeArgs[trap]
+	if !ok {
+		panic("unix: SyscallSupported: no harmless probe for system call " + strconv.Itoa(int(trap)))
+	}
+	supported := syscallProbe(trap, args) != ENOSYS
+	syscallSupported.Store(trap, supported)
+	return supported
+}
+
+// HasCopyFileRange reports whether the kernel implements
+// copy_file_range(2) (Linux >= 4.5).
+func HasCopyFileRange() bool { return SyscallSupported(SYS_COPY_FILE_RANGE) }
+
+// HasStatx reports whether the kernel implements statx(2) (Linux >= 4.11).
+func HasStatx() bool { return SyscallSupported(SYS_STATX) }
+
+// HasCloseRange reports whether the kernel implements close_range(2)
+// (Linux >= 5.9).
+func HasCloseRange() bool { return SyscallSupported(SYS_CLOSE_RANGE) }
+
+// HasFsopen reports whether the kernel implements fsopen(2) and the rest of
+// the new mount API (Linux >= 5.2).
+func HasFsopen() bool { return SyscallSupported(SYS_FSOPEN) }
+
+// HasOpenat2 reports whether the kernel implements openat2(2)
+// (Linux >= 5.6).
+func HasOpenat2() bool { return SyscallSupported(SYS_OPENAT2) }
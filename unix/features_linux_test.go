@@ -0,0 +1,75 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestHasSyscall(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		fn   func() bool
+	}{
+		{"HasFsopen", unix.HasFsopen},
+		{"HasOpenat2", unix.HasOpenat2},
+	} {
+		first := tt.fn()
+		t.Logf("%s() = %v", tt.name, first)
+		for i := 0; i < 3; i++ {
+			if got := tt.fn(); got != first {
+				t.Errorf("%s() = %v, previously returned %v", tt.name, got, first)
+			}
+		}
+	}
+
+	// Cross-check against a direct call.
+	fd, err := unix.Openat2(unix.AT_FDCWD, ".", &unix.OpenHow{Flags: unix.O_RDONLY})
+	if err == nil {
+		unix.Close(fd)
+	}
+	if want := err != unix.ENOSYS; unix.HasOpenat2() != want {
+		t.Errorf("HasOpenat2() = %v, but Openat2 returned %v", unix.HasOpenat2(), err)
+	}
+}
+
+func TestSyscallSupported(t *testing.T) {
+	if !unix.SyscallSupported(unix.SYS_GETPID) {
+		t.Fatal("SyscallSupported(SYS_GETPID) = false, want true")
+	}
+	if !unix.SyscallSupported(unix.SYS_GETPID) {
+		t.Error("cached SyscallSupported(SYS_GETPID) = false, want true")
+	}
+
+	// System calls without a known harmless probe are never called.
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("SyscallSupported(SYS_EXIT_GROUP) did not panic")
+			}
+		}()
+		unix.SyscallSupported(unix.SYS_EXIT_GROUP)
+	}()
+
+	for _, tt := range []struct {
+		name string
+		fn   func() bool
+		trap uintptr
+	}{
+		{"HasCopyFileRange", unix.HasCopyFileRange, unix.SYS_COPY_FILE_RANGE},
+		{"HasStatx", unix.HasStatx, unix.SYS_STATX},
+		{"HasOpenat2", unix.HasOpenat2, unix.SYS_OPENAT2},
+		{"HasCloseRange", unix.HasCloseRange, unix.SYS_CLOSE_RANGE},
+		{"HasFsopen", unix.HasFsopen, unix.SYS_FSOPEN},
+	} {
+		if got, want := tt.fn(), unix.SyscallSupported(tt.trap); got != want {
+			t.Errorf("%s() = %v, SyscallSupported = %v", tt.name, got, want)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || freebsd || linux
+
+package unix_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// execHelperEnv returns the environment with GO_WANT_HELPER_PROCESS set to
+// v, replacing any existing setting.
+func execHelperEnv(v string) []string {
+	var env []string
+	for _, kv := range os.Environ() {
+		if !strings.HasPrefix(kv, "GO_WANT_HELPER_PROCESS=") {
+			env = append(env, kv)
+		}
+	}
+	return append(env, "GO_WANT_HELPER_PROCESS="+v)
+}
+
+// runExecHelper runs the test binary as a helper process for the test
+// named name with GO_WANT_HELPER_PROCESS set to "exec", and checks that it
+// exits with status 42. The helper is expected to exec the test binary
+// again through the mechanism under test with GO_WANT_HELPER_PROCESS set to
+// "exit", which makes the test exit with status 42.
+func runExecHelper(t *testing.T, name string) {
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(exe, "-test.run=^"+name+"$")
+	cmd.Env = execHelperEnv("exec")
+	out, err := cmd.CombinedOutput()
+	var ee *exec.ExitError
+	if !errors.As(err, &ee) || ee.ExitCode() != 42 {
+		t.Fatalf("helper process: %v, want exit status 42\n%s", err, out)
+	}
+}
+
+func TestFexecve(t *testing.T) {
+	switch os.Getenv("GO_WANT_HELPER_PROCESS") {
+	case "exec":
+		err := func() error {
+			exe, err := os.Executable()
+			if err != nil {
+				return err
+			}
+			fd, err := unix.Open(exe, unix.O_RDONLY, 0)
+			if err != nil {
+				return err
+			}
+			return unix.Fexecve(fd, []string{exe, "-test.run=^TestFexecve$"}, execHelperEnv("exit"))
+		}()
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	case "exit":
+		os.Exit(42)
+	}
+	runExecHelper(t, "TestFexecve")
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Watching file system events with inotify, see inotify(7).
+
+package unix
+
+import "unsafe"
+
+// InotifyWatcher is an inotify instance. It owns its file descriptor and
+// the buffer events are read into, and is not safe for concurrent use by
+// multiple goroutines.
+type InotifyWatcher struct {
+	fd  int
+	buf []byte
+}
+
+// InotifyWatchEvent is an event read from an InotifyWatcher.
+type InotifyWatchEvent struct {
+	Wd     int    // watch descriptor, or -1 for IN_Q_OVERFLOW
+	Mask   uint32 // IN_* event flags
+	Cookie uint32 // connects the IN_MOVED_FROM and IN_MOVED_TO events of a rename
+	Name   string // entry name for events on the entries of a watched directory
+}
+
+// inotifyBufLen is the size of the buffer of an InotifyWatcher, large
+// enough for several events and always for one with the longest name.
+const inotifyBufLen = 4096
+
+// NewInotifyWatcher creates an inotify instance. The only flag is
+// IN_NONBLOCK; the file descriptor is always close-on-exec.
+func NewInotifyWatcher(flags int) (*InotifyWatcher, error) {
+	fd, err := InotifyInit1(flags | IN_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+	return &InotifyWatcher{fd: fd, buf: make([]byte, inotifyBufLen)}, nil
+}
+
+// Fd returns the file descriptor of w, for example to wait for events
+// with Poll.
+func (w *InotifyWatcher) Fd() int {
+	return w.fd
+}
+
+// Close closes the file descriptor of w, which removes all of its
+// watches.
+func (w *InotifyWatcher) Close() error {
+	if w.fd < 0 {
+		return EBADF
+	}
+	err := Close(w.fd)
+	w.fd = -1
+	return err
+}
+
+// AddWatch watches the file or directory at path for the IN_* events in
+// mask and returns the watch descriptor reported with its events. Watching
+// a file that is already watched returns the same descriptor and replaces
+// its mask, unless mask includes IN_MASK_ADD.
+func (w *InotifyWatcher) AddWatch(path string, mask uint32) (int, error) {
+	return InotifyAddWatch(w.fd, path, mask)
+}
+
+// RmWatch removes the watch wd. An IN_IGNORED event is generated for it.
+func (w *InotifyWatcher) RmWatch(wd int) error {
+	_, err := InotifyRmWatch(w.fd, uint32(wd))
+	return err
+}
+
+// ReadEvents reads pending events and returns them. It blocks until an
+// event is available unless w was created with IN_NONBLOCK, in which case
+// it returns EAGAIN. If the event queue overflowed, an event with Wd -1 and
+// Mask IN_Q_OVERFLOW is returned in place of the events that were lost.
+func (w *InotifyWatcher) ReadEvents() ([]InotifyWatchEvent, error) {
+	n, err := Read(w.fd, w.buf)
+	if err != nil {
+		return nil, err
+	}
+	return parseInotifyEvents(w.buf[:n]), nil
+}
+
+// parseInotifyEvents decodes the inotify_event records in b. The kernel
+// only returns whole records, but a truncated one at the end of a short
+// read is ignored rather than decoded.
+func parseInotifyEvents(b []byte) []InotifyWatchEvent {
+	var events []InotifyWatchEvent
+	for len(b) >= SizeofInotifyEvent {
+		raw := (*InotifyEvent)(unsafe.Pointer(&b[0]))
+		end := SizeofInotifyEvent + int(raw.Len)
+		if end > len(b) {
+			break
+		}
+		events = append(events, InotifyWatchEvent{
+			Wd:     int(raw.Wd),
+			Mask:   raw.Mask,
+			Cookie: raw.Cookie,
+			// The name is padded with NUL bytes to an aligned length.
+			Name: ByteSliceToString(b[SizeofInotifyEvent:end]),
+		})
+		b = b[end:]
+	}
+	return events
+}
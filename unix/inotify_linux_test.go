@@ -0,0 +1,131 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// readInotifyEvents reads the events pending on the non-blocking watcher
+// w.
+func readInotifyEvents(t *testing.T, w *unix.InotifyWatcher) []unix.InotifyWatchEvent {
+	t.Helper()
+	var events []unix.InotifyWatchEvent
+	for {
+		evs, err := w.ReadEvents()
+		if err == unix.EAGAIN {
+			return events
+		}
+		if err != nil {
+			t.Fatalf("ReadEvents: %v", err)
+		}
+		events = append(events, evs...)
+	}
+}
+
+func TestInotifyWatcher(t *testing.T) {
+	w, err := unix.NewInotifyWatcher(unix.IN_NONBLOCK)
+	if err != nil {
+		t.Fatalf("NewInotifyWatcher: %v", err)
+	}
+	defer w.Close()
+	if _, err := w.ReadEvents(); err != unix.EAGAIN {
+		t.Errorf("ReadEvents without events: got %v, want EAGAIN", err)
+	}
+
+	dir := t.TempDir()
+	wd, err := w.AddWatch(dir, unix.IN_CREATE|unix.IN_MOVE)
+	if err != nil {
+		t.Fatalf("AddWatch: %v", err)
+	}
+	long := strings.Repeat("x", unix.NAME_MAX)
+	if err := os.WriteFile(filepath.Join(dir, long), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(filepath.Join(dir, long), filepath.Join(dir, "b")); err != nil {
+		t.Fatal(err)
+	}
+
+	events := readInotifyEvents(t, w)
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3: %+v", len(events), events)
+	}
+	want := []struct {
+		mask uint32
+		name string
+	}{
+		{unix.IN_CREATE, long},
+		{unix.IN_MOVED_FROM, long},
+		{unix.IN_MOVED_TO, "b"},
+	}
+	for i, ev := range events {
+		if ev.Wd != wd || ev.Mask != want[i].mask || ev.Name != want[i].name {
+			t.Errorf("event %d: got wd %d, mask %#x, name %q; want wd %d, mask %#x, name %q",
+				i, ev.Wd, ev.Mask, ev.Name, wd, want[i].mask, want[i].name)
+		}
+	}
+	if events[1].Cookie == 0 || events[1].Cookie != events[2].Cookie {
+		t.Errorf("rename cookies: %d and %d", events[1].Cookie, events[2].Cookie)
+	}
+
+	if err := w.RmWatch(wd); err != nil {
+		t.Fatalf("RmWatch: %v", err)
+	}
+	events = readInotifyEvents(t, w)
+	if len(events) != 1 || events[0].Wd != wd || events[0].Mask != unix.IN_IGNORED {
+		t.Errorf("events after RmWatch: %+v, want IN_IGNORED", events)
+	}
+	if err := w.RmWatch(wd); err != unix.EINVAL {
+		t.Errorf("RmWatch of a removed watch: got %v, want EINVAL", err)
+	}
+}
+
+func TestInotifyOverflow(t *testing.T) {
+	b, err := os.ReadFile("/proc/sys/fs/inotify/max_queued_events")
+	if err != nil {
+		t.Skip(err)
+	}
+	limit, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil || limit > 1<<16 {
+		t.Skipf("max_queued_events %q", b)
+	}
+
+	w, err := unix.NewInotifyWatcher(unix.IN_NONBLOCK)
+	if err != nil {
+		t.Fatalf("NewInotifyWatcher: %v", err)
+	}
+	defer w.Close()
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.AddWatch(file, unix.IN_OPEN|unix.IN_CLOSE_NOWRITE); err != nil {
+		t.Fatalf("AddWatch: %v", err)
+	}
+	// Identical consecutive events are merged, so alternate between two.
+	for i := 0; i < limit; i++ {
+		fd, err := unix.Open(file, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		unix.Close(fd)
+	}
+
+	events := readInotifyEvents(t, w)
+	if len(events) != limit+1 {
+		t.Fatalf("got %d events, want %d", len(events), limit+1)
+	}
+	if last := events[len(events)-1]; last.Wd != -1 || last.Mask != unix.IN_Q_OVERFLOW {
+		t.Errorf("last event: got wd %d, mask %#x; want IN_Q_OVERFLOW", last.Wd, last.Mask)
+	}
+}
@@ -4,7 +4,10 @@
 
 package unix
 
-import "unsafe"
+import (
+	"time"
+	"unsafe"
+)
 
 // IoctlRetInt performs an ioctl operation specified by req on a device
 // associated with opened file descriptor fd, and returns a non-negative
@@ -99,11 +102,45 @@ func IoctlSetHwTstamp(fd int, ifname string, cfg *HwTstampConfig) error {
 	return ioctlIfreqData(fd, SIOCSHWTSTAMP, &ifrd)
 }
 
+// BindSocketToPHC enables hardware timestamping of all transmitted and
+// received packets on the network device with index ifindex, then sets
+// SO_TIMESTAMPING on the socket fd so that it reports the raw timestamps
+// taken by the device's PTP hardware clock. Devices without hardware
+// timestamping support fail with EOPNOTSUPP. Changing the device
+// configuration requires CAP_NET_ADMIN.
+func BindSocketToPHC(fd int, ifindex int) error {
+	var ifr Ifreq
+	ifr.SetUint32(uint32(ifindex))
+	if err := IoctlIfreq(fd, SIOCGIFNAME, &ifr); err != nil {
+		return err
+	}
+	cfg := HwTstampConfig{
+		Tx_type:   HWTSTAMP_TX_ON,
+		Rx_filter: HWTSTAMP_FILTER_ALL,
+	}
+	if err := IoctlSetHwTstamp(fd, ifr.Name(), &cfg); err != nil {
+		return err
+	}
+	flags := SOF_TIMESTAMPING_TX_HARDWARE | SOF_TIMESTAMPING_RX_HARDWARE | SOF_TIMESTAMPING_RAW_HARDWARE
+	return SetsockoptInt(fd, SOL_SOCKET, SO_TIMESTAMPING, flags)
+}
+
 // FdToClockID derives the clock ID from the file descriptor number
 // - see clock_gettime(3), FD_TO_CLOCKID macros. The resulting ID is
 // suitable for system calls like ClockGettime.
 func FdToClockID(fd int) int32 { return int32((int(^fd) << 3) | 3) }
 
+// PHCTime returns the current time of the PTP hardware clock opened as fd,
+// typically a /dev/ptpN device, by calling ClockGettime with the dynamic
+// clock ID derived from fd. PTP clocks usually run on TAI rather than UTC.
+func PHCTime(fd int) (time.Time, error) {
+	var ts Timespec
+	if err := ClockGettime(FdToClockID(fd), &ts); err != nil {
+		return time.Time{}, err
+	}
+	return ts.Time(), nil
+}
+
 // IoctlPtpClockGetcaps returns the description of a given PTP device.
 func IoctlPtpClockGetcaps(fd int) (*PtpClockCaps, error) {
 	var value PtpClockCaps
@@ -119,6 +156,16 @@ func IoctlPtpSysOffsetPrecise(fd int) (*PtpSysOffsetPrecise, error) {
 	return &value, err
 }
 
+// IoctlPtpSysOffset returns interleaved readings of the system clock
+// and the PTP clock, starting and ending with the system clock. The
+// samples parameter specifies the number of PTP clock readings, at most
+// PTP_MAX_SAMPLES.
+func IoctlPtpSysOffset(fd int, samples uint) (*PtpSysOffset, error) {
+	value := PtpSysOffset{Samples: uint32(samples)}
+	err := ioctlPtr(fd, PTP_SYS_OFFSET2, unsafe.Pointer(&value))
+	return &value, err
+}
+
 // IoctlPtpSysOffsetExtended returns an extended description of the
 // clock offset compared to the system clock. The samples parameter
 // specifies the desired number of measurements.
@@ -332,3 +379,11 @@ func IoctlLoopSetStatus64(fd int, value *LoopInfo64) error {
 func IoctlLoopConfigure(fd int, value *LoopConfig) error {
 	return ioctlPtr(fd, LOOP_CONFIGURE, unsafe.Pointer(value))
 }
+
+// IoctlBlkRRPart asks the kernel to reread the partition table of the block
+// device associated with the file descriptor fd using the BLKRRPART
+// operation. It fails with EBUSY if any partition of the device is in use,
+// for example because it is mounted.
+func IoctlBlkRRPart(fd int) error {
+	return ioctl(fd, BLKRRPART, 0)
+}
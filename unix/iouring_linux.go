@@ -0,0 +1,300 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Asynchronous I/O with io_uring, see io_uring(7).
+
+package unix
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// IoUringEnter submits toSubmit entries from the submission queue of the
+// io_uring instance fd and, with IORING_ENTER_GETEVENTS in flags, waits for
+// at least minComplete completions. If sigmask is not nil, it is installed
+// as the signal mask for the duration of the wait. It returns the number of
+// entries submitted.
+func IoUringEnter(fd int, toSubmit, minComplete, flags uint32, sigmask *Sigset_t) (int, error) {
+	var sigsetsize uintptr
+	if sigmask != nil {
+		sigsetsize = _C__NSIG / 8
+	}
+	return ioUringEnter(fd, toSubmit, minComplete, flags, sigmask, sigsetsize)
+}
+
+// IoUring is an io_uring instance with its submission and completion
+// queues mapped into memory. Entries are queued with GetSQE and one of the
+// IoUringSqe Prep methods, handed to the kernel in batches with Submit or
+// SubmitAndWait, and their results reaped with PeekCQE or WaitCQE.
+//
+// An IoUring must not be used concurrently from multiple goroutines.
+type IoUring struct {
+	fd     int
+	params IoUringParams
+
+	sqRing []byte
+	cqRing []byte // same memory as sqRing with IORING_FEAT_SINGLE_MMAP
+	sqeMem []byte
+
+	sqHead  *uint32
+	sqTail  *uint32
+	sqFlags *uint32
+	sqMask  uint32
+	sqes    []IoUringSqe
+	sqeTail uint32 // entries handed out by GetSQE, not yet published
+
+	cqHead *uint32
+	cqTail *uint32
+	cqMask uint32
+	cqes   []IoUringCqe
+}
+
+// NewIoUring creates an io_uring instance with room for at least entries
+// submission queue entries. If params is not nil, its Flags and related
+// fields configure the instance and the kernel fills in the rest; it may
+// be nil for the defaults. Instances with IORING_SETUP_SQE128 or
+// IORING_SETUP_CQE32 are not supported.
+//
+// With IORING_SETUP_NO_SQARRAY the kernel consumes submission queue
+// entries in ring order, which is how IoUring always uses them.
+func NewIoUring(entries uint32, params *IoUringParams) (*IoUring, error) {
+	r := &IoUring{fd: -1}
+	if params != nil {
+		r.params = *params
+	}
+	if r.params.Flags&(IORING_SETUP_SQE128|IORING_SETUP_CQE32) != 0 {
+		return nil, EINVAL
+	}
+	fd, err := IoUringSetup(entries, &r.params)
+	if err != nil {
+		return nil, err
+	}
+	r.fd = fd
+	if err := r.mmap(); err != nil {
+		r.Close()
+		return nil, err
+	}
+	if params != nil {
+		*params = r.params
+	}
+	return r, nil
+}
+
+func (r *IoUring) mmap() error {
+	p := &r.params
+	cqSize := int(p.Cq_off.Cqes + p.Cq_entries*SizeofIoUringCqe)
+	// Without the index array the submission queue ring is the same
+	// region as the completion queue ring, and Sq_off.Array is zero.
+	sqSize := cqSize
+	if p.Flags&IORING_SETUP_NO_SQARRAY == 0 {
+		sqSize = int(p.Sq_off.Array + p.Sq_entries*4)
+	}
+	if p.Features&IORING_FEAT_SINGLE_MMAP != 0 {
+		sqSize = max(sqSize, cqSize)
+	}
+	var err error
+	r.sqRing, err = Mmap(r.fd, IORING_OFF_SQ_RING, sqSize, PROT_READ|PROT_WRITE, MAP_SHARED|MAP_POPULATE)
+	if err != nil {
+		return err
+	}
+	if p.Features&IORING_FEAT_SINGLE_MMAP != 0 {
+		r.cqRing = r.sqRing
+	} else {
+		r.cqRing, err = Mmap(r.fd, IORING_OFF_CQ_RING, cqSize, PROT_READ|PROT_WRITE, MAP_SHARED|MAP_POPULATE)
+		if err != nil {
+			return err
+		}
+	}
+	r.sqeMem, err = Mmap(r.fd, IORING_OFF_SQES, int(p.Sq_entries)*SizeofIoUringSqe, PROT_READ|PROT_WRITE, MAP_SHARED|MAP_POPULATE)
+	if err != nil {
+		return err
+	}
+
+	r.sqHead = (*uint32)(unsafe.Pointer(&r.sqRing[p.Sq_off.Head]))
+	r.sqTail = (*uint32)(unsafe.Pointer(&r.sqRing[p.Sq_off.Tail]))
+	r.sqFlags = (*uint32)(unsafe.Pointer(&r.sqRing[p.Sq_off.Flags]))
+	r.sqMask = *(*uint32)(unsafe.Pointer(&r.sqRing[p.Sq_off.Ring_mask]))
+	r.sqes = unsafe.Slice((*IoUringSqe)(unsafe.Pointer(&r.sqeMem[0])), p.Sq_entries)
+	r.sqeTail = *r.sqTail
+	// Submission queue entries are always used in order, so the index
+	// array is the identity mapping.
+	if p.Flags&IORING_SETUP_NO_SQARRAY == 0 {
+		array := unsafe.Slice((*uint32)(unsafe.Pointer(&r.sqRing[p.Sq_off.Array])), p.Sq_entries)
+		for i := range array {
+			array[i] = uint32(i)
+		}
+	}
+
+	r.cqHead = (*uint32)(unsafe.Pointer(&r.cqRing[p.Cq_off.Head]))
+	r.cqTail = (*uint32)(unsafe.Pointer(&r.cqRing[p.Cq_off.Tail]))
+	r.cqMask = *(*uint32)(unsafe.Pointer(&r.cqRing[p.Cq_off.Ring_mask]))
+	r.cqes = unsafe.Slice((*IoUringCqe)(unsafe.Pointer(&r.cqRing[p.Cq_off.Cqes])), p.Cq_entries)
+	return nil
+}
+
+// Close unmaps the queues and closes the io_uring file descriptor.
+// Operations still in flight are canceled by the kernel.
+func (r *IoUring) Close() error {
+	if r.sqeMem != nil {
+		Munmap(r.sqeMem)
+	}
+	if r.cqRing != nil && unsafe.SliceData(r.cqRing) != unsafe.SliceData(r.sqRing) {
+		Munmap(r.cqRing)
+	}
+	if r.sqRing != nil {
+		Munmap(r.sqRing)
+	}
+	r.sqRing, r.cqRing, r.sqeMem, r.sqes, r.cqes = nil, nil, nil, nil, nil
+	if r.fd < 0 {
+		return nil
+	}
+	err := Close(r.fd)
+	r.fd = -1
+	return err
+}
+
+// Fd returns the io_uring file descriptor, for use with IoUringRegister.
+func (r *IoUring) Fd() int { return r.fd }
+
+// Params returns the parameters of the instance as filled in by the kernel,
+// including the IORING_FEAT_* flags in Features.
+func (r *IoUring) Params() IoUringParams { return r.params }
+
+// GetSQE returns the next free submission queue entry, cleared, or nil if
+// the queue is full and Submit must be called first. The entry is handed to
+// the kernel by the next call to Submit or SubmitAndWait.
+func (r *IoUring) GetSQE() *IoUringSqe {
+	if r.sqeTail-atomic.LoadUint32(r.sqHead) >= uint32(len(r.sqes)) {
+		return nil
+	}
+	sqe := &r.sqes[r.sqeTail&r.sqMask]
+	*sqe = IoUringSqe{}
+	r.sqeTail++
+	return sqe
+}
+
+// flush publishes the entries returned by GetSQE to the kernel and returns
+// the number of entries that it has not consumed yet.
+func (r *IoUring) flush() uint32 {
+	atomic.StoreUint32(r.sqTail, r.sqeTail)
+	return r.sqeTail - atomic.LoadUint32(r.sqHead)
+}
+
+// Submit hands the queued submission queue entries to the kernel in a
+// single system call and returns the number submitted.
+func (r *IoUring) Submit() (int, error) {
+	return r.SubmitAndWait(0)
+}
+
+// SubmitAndWait hands the queued submission queue entries to the kernel and
+// waits until at least waitNr completions are available. It returns the
+// number of entries submitted. The wait is restarted if it is interrupted
+// by a signal.
+func (r *IoUring) SubmitAndWait(waitNr uint32) (int, error) {
+	n := r.flush()
+	var flags uint32
+	if waitNr > 0 {
+		flags |= IORING_ENTER_GETEVENTS
+	}
+	if r.params.Flags&IORING_SETUP_SQPOLL != 0 {
+		// The kernel thread consumes the queue on its own and only needs
+		// to be woken up when it went idle.
+		if atomic.LoadUint32(r.sqFlags)&IORING_SQ_NEED_WAKEUP != 0 {
+			flags |= IORING_ENTER_SQ_WAKEUP
+		}
+		if flags == 0 {
+			return int(n), nil
+		}
+		n = 0
+	}
+	for {
+		submitted, err := IoUringEnter(r.fd, n, waitNr, flags, nil)
+		if err != EINTR {
+			return submitted, err
+		}
+		// Entries submitted before the interruption have been consumed.
+		n = r.sqeTail - atomic.LoadUint32(r.sqHead)
+	}
+}
+
+// PeekCQE returns the next completion queue entry, if any, and removes it
+// from the queue.
+func (r *IoUring) PeekCQE() (cqe IoUringCqe, ok bool) {
+	head := atomic.LoadUint32(r.cqHead)
+	if head == atomic.LoadUint32(r.cqTail) {
+		return IoUringCqe{}, false
+	}
+	cqe = r.cqes[head&r.cqMask]
+	atomic.StoreUint32(r.cqHead, head+1)
+	return cqe, true
+}
+
+// WaitCQE returns the next completion queue entry, waiting for one if the
+// queue is empty, and removes it from the queue.
+func (r *IoUring) WaitCQE() (IoUringCqe, error) {
+	for {
+		if cqe, ok := r.PeekCQE(); ok {
+			return cqe, nil
+		}
+		_, err := IoUringEnter(r.fd, 0, 1, IORING_ENTER_GETEVENTS, nil)
+		if err != nil && err != EINTR {
+			return IoUringCqe{}, err
+		}
+	}
+}
+
+// The Prep methods fill in a submission queue entry returned by GetSQE for
+// one operation, leaving User_data and Flags to the caller. Buffers are
+// referenced by address: they must not be used by the program, and must be
+// kept reachable, for example with runtime.KeepAlive, until the completion
+// of the operation has been reaped.
+
+// PrepNop prepares an operation that does nothing and completes with 0.
+func (sqe *IoUringSqe) PrepNop() {
+	sqe.Opcode = IORING_OP_NOP
+	sqe.Fd = -1
+}
+
+// PrepRead prepares a read(2) of up to len(buf) bytes from fd at offset, or
+// at the current file position if offset is -1.
+func (sqe *IoUringSqe) PrepRead(fd int, buf []byte, offset int64) {
+	sqe.prepRW(IORING_OP_READ, fd, buf, uint64(offset))
+}
+
+// PrepWrite prepares a write(2) of buf to fd at offset, or at the current
+// file position if offset is -1.
+func (sqe *IoUringSqe) PrepWrite(fd int, buf []byte, offset int64) {
+	sqe.prepRW(IORING_OP_WRITE, fd, buf, uint64(offset))
+}
+
+// PrepSend prepares a send(2) of buf on the socket fd with the MSG_* flags.
+func (sqe *IoUringSqe) PrepSend(fd int, buf []byte, flags int) {
+	sqe.prepRW(IORING_OP_SEND, fd, buf, 0)
+	sqe.Op_flags = uint32(flags)
+}
+
+// PrepRecv prepares a recv(2) into buf from the socket fd with the MSG_*
+// flags.
+func (sqe *IoUringSqe) PrepRecv(fd int, buf []byte, flags int) {
+	sqe.prepRW(IORING_OP_RECV, fd, buf, 0)
+	sqe.Op_flags = uint32(flags)
+}
+
+// PrepAccept prepares an accept4(2) on the listening socket fd with the
+// SOCK_* flags. The new connection's file descriptor is the result of the
+// completion; use Getpeername to learn the peer address.
+func (sqe *IoUringSqe) PrepAccept(fd int, flags int) {
+	sqe.Opcode = IORING_OP_ACCEPT
+	sqe.Fd = int32(fd)
+	sqe.Op_flags = uint32(flags)
+}
+
+func (sqe *IoUringSqe) prepRW(op uint8, fd int, buf []byte, off uint64) {
+	sqe.Opcode = op
+	sqe.Fd = int32(fd)
+	sqe.Addr = kernelAddr(unsafe.Pointer(unsafe.SliceData(buf)))
+	sqe.Len = uint32(len(buf))
+	sqe.Off = off
+}
@@ -0,0 +1,236 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// newIoUring creates an io_uring instance, skipping the test if io_uring is
+// not available or disabled by the io_uring_disabled sysctl.
+func newIoUring(t *testing.T, entries uint32) *unix.IoUring {
+	t.Helper()
+	r, err := unix.NewIoUring(entries, nil)
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("io_uring not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("NewIoUring: %v", err)
+	}
+	t.Cleanup(func() { r.Close() })
+	return r
+}
+
+// submitOne submits the queued entries and returns the next completion,
+// failing the test if it does not carry the given user data.
+func submitOne(t *testing.T, r *unix.IoUring, userData uint64) unix.IoUringCqe {
+	t.Helper()
+	if _, err := r.SubmitAndWait(1); err != nil {
+		t.Fatalf("SubmitAndWait: %v", err)
+	}
+	cqe, err := r.WaitCQE()
+	if err != nil {
+		t.Fatalf("WaitCQE: %v", err)
+	}
+	if cqe.User_data != userData {
+		t.Fatalf("completion user data: got %#x, want %#x", cqe.User_data, userData)
+	}
+	return cqe
+}
+
+func TestIoUringTypes(t *testing.T) {
+	if got := unsafe.Sizeof(unix.IoUringSqe{}); got != unix.SizeofIoUringSqe {
+		t.Errorf("sizeof IoUringSqe: got %d, want %d", got, unix.SizeofIoUringSqe)
+	}
+	if got := unsafe.Sizeof(unix.IoUringCqe{}); got != unix.SizeofIoUringCqe {
+		t.Errorf("sizeof IoUringCqe: got %d, want %d", got, unix.SizeofIoUringCqe)
+	}
+	if got := unsafe.Sizeof(unix.IoUringParams{}); got != unix.SizeofIoUringParams {
+		t.Errorf("sizeof IoUringParams: got %d, want %d", got, unix.SizeofIoUringParams)
+	}
+}
+
+func TestIoUringNop(t *testing.T) {
+	r := newIoUring(t, 4)
+
+	for i := 0; i < 4; i++ {
+		sqe := r.GetSQE()
+		if sqe == nil {
+			t.Fatalf("GetSQE %d: queue full", i)
+		}
+		sqe.PrepNop()
+		sqe.User_data = uint64(i + 1)
+	}
+	if sqe := r.GetSQE(); sqe != nil {
+		t.Errorf("GetSQE on a full queue returned an entry")
+	}
+	n, err := r.SubmitAndWait(4)
+	if err != nil {
+		t.Fatalf("SubmitAndWait: %v", err)
+	}
+	if n != 4 {
+		t.Errorf("SubmitAndWait: submitted %d entries, want 4", n)
+	}
+	for i := 0; i < 4; i++ {
+		cqe, ok := r.PeekCQE()
+		if !ok {
+			t.Fatalf("PeekCQE %d: queue empty", i)
+		}
+		if cqe.User_data != uint64(i+1) || cqe.Res != 0 {
+			t.Errorf("completion %d: got user data %d, result %d", i, cqe.User_data, cqe.Res)
+		}
+	}
+	if _, ok := r.PeekCQE(); ok {
+		t.Errorf("PeekCQE on an empty queue returned an entry")
+	}
+	if r.GetSQE() == nil {
+		t.Errorf("GetSQE after Submit: queue still full")
+	}
+}
+
+func TestIoUringNoSQArray(t *testing.T) {
+	params := unix.IoUringParams{Flags: unix.IORING_SETUP_NO_SQARRAY}
+	r, err := unix.NewIoUring(4, &params)
+	if err == unix.ENOSYS || err == unix.EPERM || err == unix.EINVAL {
+		t.Skipf("io_uring with IORING_SETUP_NO_SQARRAY not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("NewIoUring: %v", err)
+	}
+	defer r.Close()
+
+	// Go around the ring a few times so that a clobbered ring header
+	// shows up as missing or extra completions.
+	for i := 0; i < 12; i++ {
+		sqe := r.GetSQE()
+		if sqe == nil {
+			t.Fatalf("GetSQE %d: queue full", i)
+		}
+		sqe.PrepNop()
+		sqe.User_data = uint64(i + 1)
+		submitOne(t, r, uint64(i+1))
+	}
+	if _, ok := r.PeekCQE(); ok {
+		t.Errorf("PeekCQE on an empty queue returned an entry")
+	}
+}
+
+func TestIoUringReadWrite(t *testing.T) {
+	r := newIoUring(t, 8)
+	var p [2]int
+	if err := unix.Pipe2(p[:], unix.O_CLOEXEC); err != nil {
+		t.Fatalf("Pipe2: %v", err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+
+	msg := []byte("hello, io_uring")
+	sqe := r.GetSQE()
+	sqe.PrepWrite(p[1], msg, -1)
+	sqe.User_data = 1
+	if cqe := submitOne(t, r, 1); cqe.Res != int32(len(msg)) {
+		t.Fatalf("write: got result %d, want %d", cqe.Res, len(msg))
+	}
+
+	buf := make([]byte, 64)
+	sqe = r.GetSQE()
+	sqe.PrepRead(p[0], buf, -1)
+	sqe.User_data = 2
+	cqe := submitOne(t, r, 2)
+	if cqe.Res < 0 {
+		t.Fatalf("read: %v", unix.Errno(-cqe.Res))
+	}
+	if got := buf[:cqe.Res]; !bytes.Equal(got, msg) {
+		t.Errorf("read: got %q, want %q", got, msg)
+	}
+	runtime.KeepAlive(msg)
+	runtime.KeepAlive(buf)
+}
+
+func TestIoUringSendRecv(t *testing.T) {
+	r := newIoUring(t, 8)
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Socketpair: %v", err)
+	}
+	defer unix.Close(fds[0])
+	defer unix.Close(fds[1])
+
+	// Queue the receive first so that it is completed by the send.
+	buf := make([]byte, 16)
+	sqe := r.GetSQE()
+	sqe.PrepRecv(fds[1], buf, 0)
+	sqe.User_data = 1
+	if _, err := r.Submit(); err != nil {
+		t.Fatalf("Submit: %v", err)
+	}
+
+	msg := []byte("ping")
+	sqe = r.GetSQE()
+	sqe.PrepSend(fds[0], msg, unix.MSG_NOSIGNAL)
+	sqe.User_data = 2
+	if _, err := r.SubmitAndWait(2); err != nil {
+		t.Fatalf("SubmitAndWait: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		cqe, err := r.WaitCQE()
+		if err != nil {
+			t.Fatalf("WaitCQE: %v", err)
+		}
+		if cqe.Res != int32(len(msg)) {
+			t.Errorf("completion %d: got result %d, want %d", cqe.User_data, cqe.Res, len(msg))
+		}
+	}
+	if got := buf[:len(msg)]; !bytes.Equal(got, msg) {
+		t.Errorf("recv: got %q, want %q", got, msg)
+	}
+	runtime.KeepAlive(msg)
+	runtime.KeepAlive(buf)
+}
+
+func TestIoUringAccept(t *testing.T) {
+	r := newIoUring(t, 4)
+	ln, port := tcpListener(t)
+
+	sqe := r.GetSQE()
+	sqe.PrepAccept(ln, unix.SOCK_CLOEXEC)
+	sqe.User_data = 1
+	if _, err := r.Submit(); err != nil {
+		t.Fatalf("Submit: %v", err)
+	}
+
+	client := tcpSocket(t)
+	if err := unix.Connect(client, &unix.SockaddrInet4{Port: port, Addr: [4]byte{127, 0, 0, 1}}); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	cqe, err := r.WaitCQE()
+	if err != nil {
+		t.Fatalf("WaitCQE: %v", err)
+	}
+	if cqe.Res < 0 {
+		t.Fatalf("accept: %v", unix.Errno(-cqe.Res))
+	}
+	conn := int(cqe.Res)
+	defer unix.Close(conn)
+
+	peer, err := unix.Getpeername(conn)
+	if err != nil {
+		t.Fatalf("Getpeername: %v", err)
+	}
+	local, err := unix.Getsockname(client)
+	if err != nil {
+		t.Fatalf("Getsockname: %v", err)
+	}
+	if got, want := peer.(*unix.SockaddrInet4).Port, local.(*unix.SockaddrInet4).Port; got != want {
+		t.Errorf("accepted connection peer port: got %d, want %d", got, want)
+	}
+}
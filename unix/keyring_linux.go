@@ -0,0 +1,160 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Kernel key management, see keyrings(7).
+
+package unix
+
+import (
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// Keyring is a kernel keyring, identified by its serial number or by one of
+// the KEY_SPEC_* special keyring IDs such as KEY_SPEC_SESSION_KEYRING. The
+// special IDs are resolved by the kernel relative to the calling thread on
+// each call; use Serial to get the keyring they currently refer to.
+type Keyring int
+
+// Serial returns the serial number of r, resolving a KEY_SPEC_* special
+// keyring ID. If create is true, a missing thread, process or session
+// keyring is created.
+func (r Keyring) Serial(create bool) (int, error) {
+	return KeyctlGetKeyringID(int(r), create)
+}
+
+// Add creates a key of the given type and description with payload and
+// links it into r, or updates the payload of a matching key already linked
+// into r. It returns the serial number of the key.
+func (r Keyring) Add(keyType, description string, payload []byte) (int, error) {
+	return AddKey(keyType, description, payload, int(r))
+}
+
+// AddKeyring creates a keyring named name and links it into r.
+func (r Keyring) AddKeyring(name string) (Keyring, error) {
+	id, err := AddKey("keyring", name, nil, int(r))
+	return Keyring(id), err
+}
+
+// Request looks for a key of the given type and description in the
+// keyrings of the calling thread and, if it is not found and callout is
+// not empty, asks /sbin/request-key to instantiate it with callout as
+// information. The key found or created is linked into r.
+func (r Keyring) Request(keyType, description, callout string) (int, error) {
+	return RequestKey(keyType, description, callout, int(r))
+}
+
+// Search searches r and the keyrings nested in it for a key of the given
+// type and description and returns its serial number. It returns ENOKEY if
+// no such key is found.
+func (r Keyring) Search(keyType, description string) (int, error) {
+	return KeyctlSearch(int(r), keyType, description, 0)
+}
+
+// Link links the key with serial number id into r.
+func (r Keyring) Link(id int) error {
+	_, err := KeyctlInt(KEYCTL_LINK, id, int(r), 0, 0)
+	return err
+}
+
+// Unlink removes the link to the key with serial number id from r. The key
+// is destroyed once no keyring links to it anymore.
+func (r Keyring) Unlink(id int) error {
+	_, err := KeyctlInt(KEYCTL_UNLINK, id, int(r), 0, 0)
+	return err
+}
+
+// Clear unlinks all the keys linked into r.
+func (r Keyring) Clear() error {
+	_, err := KeyctlInt(KEYCTL_CLEAR, int(r), 0, 0, 0)
+	return err
+}
+
+// SetPerm sets the permission mask of r, see KeyctlSetperm.
+func (r Keyring) SetPerm(perm uint32) error {
+	return KeyctlSetperm(int(r), perm)
+}
+
+// Instantiate instantiates the key under construction with serial number
+// id with payload and links it into r. It is used by request-key handlers,
+// which must have assumed the authority to do so with
+// KEYCTL_ASSUME_AUTHORITY.
+func (r Keyring) Instantiate(id int, payload []byte) error {
+	_, err := KeyctlBuffer(KEYCTL_INSTANTIATE, id, payload, int(r))
+	return err
+}
+
+// Keys returns the serial numbers of the keys linked into r.
+func (r Keyring) Keys() ([]int, error) {
+	b, err := KeyctlRead(int(r))
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(b)/4)
+	for ; len(b) >= 4; b = b[4:] {
+		ids = append(ids, int(*(*int32)(unsafe.Pointer(&b[0]))))
+	}
+	return ids, nil
+}
+
+// KeyctlRead implements the KEYCTL_READ command. It returns the payload of
+// the key with serial number id, or for a keyring the serial numbers of its
+// keys as 32-bit integers in native byte order.
+func KeyctlRead(id int) ([]byte, error) {
+	// As in KeyctlString, the payload may change in between the calls.
+	var buf []byte
+	for {
+		n, err := KeyctlBuffer(KEYCTL_READ, id, buf, 0)
+		if err != nil {
+			return nil, err
+		}
+		if n <= len(buf) {
+			return buf[:n], nil
+		}
+		buf = make([]byte, n)
+	}
+}
+
+// KeyDescription describes a key as reported by KeyctlDescribe.
+type KeyDescription struct {
+	Type        string
+	Uid         int
+	Gid         int
+	Perm        uint32 // permission mask, see KeyctlSetperm
+	Description string
+}
+
+// KeyctlDescribe implements the KEYCTL_DESCRIBE command and parses its
+// result for the key with serial number id.
+func KeyctlDescribe(id int) (*KeyDescription, error) {
+	s, err := KeyctlString(KEYCTL_DESCRIBE, id)
+	if err != nil {
+		return nil, err
+	}
+	// The description comes last and may itself contain semicolons.
+	f := strings.SplitN(s, ";", 5)
+	if len(f) != 5 {
+		return nil, EINVAL
+	}
+	uid, err := strconv.Atoi(f[1])
+	if err != nil {
+		return nil, EINVAL
+	}
+	gid, err := strconv.Atoi(f[2])
+	if err != nil {
+		return nil, EINVAL
+	}
+	perm, err := strconv.ParseUint(f[3], 16, 32)
+	if err != nil {
+		return nil, EINVAL
+	}
+	return &KeyDescription{
+		Type:        f[0],
+		Uid:         uid,
+		Gid:         gid,
+		Perm:        uint32(perm),
+		Description: f[4],
+	}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// testKeyring creates a keyring linked into the process keyring, skipping
+// the test if the key management facility is not available.
+func testKeyring(t *testing.T) unix.Keyring {
+	t.Helper()
+	ring, err := unix.Keyring(unix.KEY_SPEC_PROCESS_KEYRING).AddKeyring("go-test")
+	if err == unix.ENOSYS || err == unix.EPERM || err == unix.EACCES {
+		t.Skipf("keyrings not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("AddKeyring: %v", err)
+	}
+	t.Cleanup(func() {
+		unix.Keyring(unix.KEY_SPEC_PROCESS_KEYRING).Unlink(int(ring))
+	})
+	return ring
+}
+
+func TestKeyring(t *testing.T) {
+	ring := testKeyring(t)
+
+	payload := []byte("secret")
+	id, err := ring.Add("user", "go;test", payload)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	got, err := unix.KeyctlRead(id)
+	if err != nil {
+		t.Fatalf("KeyctlRead: %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Errorf("KeyctlRead: got %q, want %q", got, payload)
+	}
+
+	desc, err := unix.KeyctlDescribe(id)
+	if err != nil {
+		t.Fatalf("KeyctlDescribe: %v", err)
+	}
+	if desc.Type != "user" || desc.Description != "go;test" || desc.Uid != unix.Geteuid() || desc.Gid != unix.Getegid() {
+		t.Errorf("KeyctlDescribe: got %+v", desc)
+	}
+
+	if found, err := ring.Search("user", "go;test"); err != nil || found != id {
+		t.Errorf("Search = %d, %v; want %d", found, err, id)
+	}
+	if _, err := ring.Search("user", "missing"); err != unix.ENOKEY {
+		t.Errorf("Search for a missing key: got %v, want ENOKEY", err)
+	}
+
+	other, err := ring.AddKeyring("go-test-other")
+	if err != nil {
+		t.Fatalf("AddKeyring: %v", err)
+	}
+	if err := other.Link(id); err != nil {
+		t.Fatalf("Link: %v", err)
+	}
+	if ids, err := other.Keys(); err != nil || len(ids) != 1 || ids[0] != id {
+		t.Errorf("Keys after Link = %v, %v; want [%d]", ids, err, id)
+	}
+	if err := other.Unlink(id); err != nil {
+		t.Fatalf("Unlink: %v", err)
+	}
+	if ids, err := other.Keys(); err != nil || len(ids) != 0 {
+		t.Errorf("Keys after Unlink = %v, %v; want none", ids, err)
+	}
+
+	if err := ring.Clear(); err != nil {
+		t.Fatalf("Clear: %v", err)
+	}
+	if _, err := ring.Search("user", "go;test"); err != unix.ENOKEY {
+		t.Errorf("Search after Clear: got %v, want ENOKEY", err)
+	}
+}
+
+func TestKeyringSetPerm(t *testing.T) {
+	ring := testKeyring(t)
+
+	desc, err := unix.KeyctlDescribe(int(ring))
+	if err != nil {
+		t.Fatalf("KeyctlDescribe: %v", err)
+	}
+	// Drop the possessor write permission, keeping the others.
+	const posWrite = 0x04000000
+	perm := desc.Perm &^ posWrite
+	if err := ring.SetPerm(perm); err != nil {
+		t.Fatalf("SetPerm: %v", err)
+	}
+	if desc, err := unix.KeyctlDescribe(int(ring)); err != nil || desc.Perm != perm {
+		t.Errorf("KeyctlDescribe after SetPerm = %+v, %v; want perm %#x", desc, err, perm)
+	}
+}
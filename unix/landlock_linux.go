@@ -0,0 +1,129 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Unprivileged access control with Landlock, see landlock(7).
+
+package unix
+
+import "unsafe"
+
+// LandlockABIVersion returns the Landlock ABI version supported by the
+// running kernel. Each version adds access rights, see ClampToABI. It
+// returns EOPNOTSUPP if Landlock is supported but disabled at boot time,
+// and ENOSYS if it is not supported.
+func LandlockABIVersion() (int, error) {
+	return LandlockCreateRuleset(nil, 0, LANDLOCK_CREATE_RULESET_VERSION)
+}
+
+// ClampToABI clears from attr the access rights that are unknown to the
+// given Landlock ABI version, as returned by LandlockABIVersion, so that a
+// ruleset written for a recent kernel enforces as much as possible on an
+// older one instead of failing to be created.
+func (attr *LandlockRulesetAttr) ClampToABI(abi int) {
+	var fs, net, scoped uint64
+	if abi >= 1 {
+		fs = LANDLOCK_ACCESS_FS_EXECUTE | LANDLOCK_ACCESS_FS_WRITE_FILE |
+			LANDLOCK_ACCESS_FS_READ_FILE | LANDLOCK_ACCESS_FS_READ_DIR |
+			LANDLOCK_ACCESS_FS_REMOVE_DIR | LANDLOCK_ACCESS_FS_REMOVE_FILE |
+			LANDLOCK_ACCESS_FS_MAKE_CHAR | LANDLOCK_ACCESS_FS_MAKE_DIR |
+			LANDLOCK_ACCESS_FS_MAKE_REG | LANDLOCK_ACCESS_FS_MAKE_SOCK |
+			LANDLOCK_ACCESS_FS_MAKE_FIFO | LANDLOCK_ACCESS_FS_MAKE_BLOCK |
+			LANDLOCK_ACCESS_FS_MAKE_SYM
+	}
+	if abi >= 2 {
+		fs |= LANDLOCK_ACCESS_FS_REFER
+	}
+	if abi >= 3 {
+		fs |= LANDLOCK_ACCESS_FS_TRUNCATE
+	}
+	if abi >= 4 {
+		net = LANDLOCK_ACCESS_NET_BIND_TCP | LANDLOCK_ACCESS_NET_CONNECT_TCP
+	}
+	if abi >= 5 {
+		fs |= LANDLOCK_ACCESS_FS_IOCTL_DEV
+	}
+	if abi >= 6 {
+		scoped = LANDLOCK_SCOPE_ABSTRACT_UNIX_SOCKET | LANDLOCK_SCOPE_SIGNAL
+	}
+	attr.Access_fs &= fs
+	attr.Access_net &= net
+	attr.Scoped &= scoped
+}
+
+// LandlockRuleset is a Landlock ruleset being built. Rules added with its
+// Allow methods grant exceptions to the access rights handled by the
+// ruleset, which are denied by default once RestrictSelf is called.
+type LandlockRuleset struct {
+	fd int
+}
+
+// NewLandlockRuleset creates a ruleset handling the access rights in attr:
+// filesystem rights in Access_fs (LANDLOCK_ACCESS_FS_*), network rights in
+// Access_net (LANDLOCK_ACCESS_NET_*, ABI 4) and IPC scopes in Scoped
+// (LANDLOCK_SCOPE_*, ABI 6). Rights unknown to the running kernel make it
+// fail with EINVAL, or E2BIG for a whole field; use ClampToABI to drop
+// them instead.
+func NewLandlockRuleset(attr *LandlockRulesetAttr) (*LandlockRuleset, error) {
+	fd, err := LandlockCreateRuleset(attr, unsafe.Sizeof(*attr), 0)
+	if err != nil {
+		return nil, err
+	}
+	return &LandlockRuleset{fd: fd}, nil
+}
+
+// Fd returns the ruleset file descriptor, for use with LandlockAddRule and
+// LandlockRestrictSelf.
+func (r *LandlockRuleset) Fd() int { return r.fd }
+
+// Close closes the ruleset file descriptor. Restrictions already enforced
+// with RestrictSelf stay in place.
+func (r *LandlockRuleset) Close() error {
+	if r.fd < 0 {
+		return nil
+	}
+	err := Close(r.fd)
+	r.fd = -1
+	return err
+}
+
+// AllowPathBeneath allows the filesystem access rights in access on the
+// file, or directory hierarchy, referred to by parentFd. The file
+// descriptor is preferably opened with O_PATH. It returns EINVAL if access
+// contains rights that are not handled by the ruleset, or that only apply
+// to directories while parentFd is not one.
+func (r *LandlockRuleset) AllowPathBeneath(parentFd int, access uint64) error {
+	attr := LandlockPathBeneathAttr{Allowed_access: access, Parent_fd: int32(parentFd)}
+	return LandlockAddRule(r.fd, LANDLOCK_RULE_PATH_BENEATH, unsafe.Pointer(&attr), 0)
+}
+
+// AllowPath is like AllowPathBeneath for the file or directory at path.
+func (r *LandlockRuleset) AllowPath(path string, access uint64) error {
+	fd, err := Open(path, O_PATH|O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer Close(fd)
+	return r.AllowPathBeneath(fd, access)
+}
+
+// AllowPort allows the network access rights in access, such as
+// LANDLOCK_ACCESS_NET_CONNECT_TCP, for the given TCP port. It requires
+// Landlock ABI 4.
+func (r *LandlockRuleset) AllowPort(port uint16, access uint64) error {
+	attr := LandlockNetPortAttr{Allowed_access: access, Port: uint64(port)}
+	return LandlockAddRule(r.fd, LANDLOCK_RULE_NET_PORT, unsafe.Pointer(&attr), 0)
+}
+
+// RestrictSelf sets no_new_privs with PR_SET_NO_NEW_PRIVS, which Landlock
+// requires of callers without CAP_SYS_ADMIN, and enforces the ruleset.
+// Neither can be undone. Both only apply to the calling thread and the
+// threads and processes it creates later, so callers should use
+// runtime.LockOSThread and do the restricted work on that thread, or in a
+// child process started from it.
+func (r *LandlockRuleset) RestrictSelf() error {
+	if err := Prctl(PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return err
+	}
+	return LandlockRestrictSelf(r.fd, 0)
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// landlockABI returns the Landlock ABI version, skipping the test if
+// Landlock is not available.
+func landlockABI(t *testing.T) int {
+	t.Helper()
+	abi, err := unix.LandlockABIVersion()
+	if err != nil {
+		t.Skipf("Landlock not available: %v", err)
+	}
+	if abi < 1 {
+		t.Fatalf("LandlockABIVersion: got %d", abi)
+	}
+	return abi
+}
+
+// restricted runs fn on a new locked thread after enforcing r on it. The
+// thread is terminated afterwards instead of being returned to the
+// runtime.
+func restricted(t *testing.T, r *unix.LandlockRuleset, fn func()) {
+	t.Helper()
+	errc := make(chan error)
+	go func() {
+		runtime.LockOSThread()
+		// No UnlockOSThread: the thread must not be reused.
+		if err := r.RestrictSelf(); err != nil {
+			errc <- err
+			return
+		}
+		fn()
+		errc <- nil
+	}()
+	if err := <-errc; err != nil {
+		t.Fatalf("RestrictSelf: %v", err)
+	}
+}
+
+func TestLandlockClampToABI(t *testing.T) {
+	attr := unix.LandlockRulesetAttr{
+		Access_fs:  unix.LANDLOCK_ACCESS_FS_READ_FILE | unix.LANDLOCK_ACCESS_FS_REFER | unix.LANDLOCK_ACCESS_FS_IOCTL_DEV,
+		Access_net: unix.LANDLOCK_ACCESS_NET_BIND_TCP,
+		Scoped:     unix.LANDLOCK_SCOPE_SIGNAL,
+	}
+	a := attr
+	a.ClampToABI(1)
+	if a.Access_fs != unix.LANDLOCK_ACCESS_FS_READ_FILE || a.Access_net != 0 || a.Scoped != 0 {
+		t.Errorf("ClampToABI(1): got %+v", a)
+	}
+	a = attr
+	a.ClampToABI(4)
+	if a.Access_fs != unix.LANDLOCK_ACCESS_FS_READ_FILE|unix.LANDLOCK_ACCESS_FS_REFER || a.Access_net != attr.Access_net || a.Scoped != 0 {
+		t.Errorf("ClampToABI(4): got %+v", a)
+	}
+	a = attr
+	a.ClampToABI(6)
+	if a != attr {
+		t.Errorf("ClampToABI(6): got %+v, want %+v", a, attr)
+	}
+}
+
+func TestLandlockRulesetPath(t *testing.T) {
+	abi := landlockABI(t)
+
+	allowed := t.TempDir()
+	allowedFile := filepath.Join(allowed, "file")
+	deniedFile := filepath.Join(t.TempDir(), "file")
+	for _, f := range []string{allowedFile, deniedFile} {
+		if err := os.WriteFile(f, []byte("data"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	attr := unix.LandlockRulesetAttr{
+		Access_fs: unix.LANDLOCK_ACCESS_FS_READ_FILE | unix.LANDLOCK_ACCESS_FS_WRITE_FILE,
+	}
+	attr.ClampToABI(abi)
+	r, err := unix.NewLandlockRuleset(&attr)
+	if err != nil {
+		t.Fatalf("NewLandlockRuleset: %v", err)
+	}
+	defer r.Close()
+	if err := r.AllowPath(allowed, unix.LANDLOCK_ACCESS_FS_MAKE_DIR); err != unix.EINVAL {
+		t.Errorf("AllowPath with an unhandled right: got %v, want EINVAL", err)
+	}
+	if err := r.AllowPath(allowed, unix.LANDLOCK_ACCESS_FS_READ_FILE); err != nil {
+		t.Fatalf("AllowPath: %v", err)
+	}
+
+	restricted(t, r, func() {
+		if fd, err := unix.Open(allowedFile, unix.O_RDONLY|unix.O_CLOEXEC, 0); err != nil {
+			t.Errorf("reading an allowed file: %v", err)
+		} else {
+			unix.Close(fd)
+		}
+		if _, err := unix.Open(allowedFile, unix.O_WRONLY|unix.O_CLOEXEC, 0); err != unix.EACCES {
+			t.Errorf("writing an allowed file: got %v, want EACCES", err)
+		}
+		if _, err := unix.Open(deniedFile, unix.O_RDONLY|unix.O_CLOEXEC, 0); err != unix.EACCES {
+			t.Errorf("reading a denied file: got %v, want EACCES", err)
+		}
+	})
+
+	// Other threads are not restricted.
+	if fd, err := unix.Open(deniedFile, unix.O_RDWR|unix.O_CLOEXEC, 0); err != nil {
+		t.Errorf("Open on an unrestricted thread: %v", err)
+	} else {
+		unix.Close(fd)
+	}
+}
+
+func TestLandlockRulesetPort(t *testing.T) {
+	if abi := landlockABI(t); abi < 4 {
+		t.Skipf("Landlock ABI %d does not support network rules", abi)
+	}
+	_, allowedPort := tcpListener(t)
+	_, deniedPort := tcpListener(t)
+
+	r, err := unix.NewLandlockRuleset(&unix.LandlockRulesetAttr{
+		Access_net: unix.LANDLOCK_ACCESS_NET_CONNECT_TCP,
+	})
+	if err != nil {
+		t.Fatalf("NewLandlockRuleset: %v", err)
+	}
+	defer r.Close()
+	if err := r.AllowPort(uint16(allowedPort), unix.LANDLOCK_ACCESS_NET_CONNECT_TCP); err != nil {
+		t.Fatalf("AllowPort: %v", err)
+	}
+
+	allowed, denied := tcpSocket(t), tcpSocket(t)
+	restricted(t, r, func() {
+		if err := unix.Connect(allowed, &unix.SockaddrInet4{Port: allowedPort, Addr: [4]byte{127, 0, 0, 1}}); err != nil {
+			t.Errorf("connecting to an allowed port: %v", err)
+		}
+		if err := unix.Connect(denied, &unix.SockaddrInet4{Port: deniedPort, Addr: [4]byte{127, 0, 0, 1}}); err != unix.EACCES {
+			t.Errorf("connecting to a denied port: got %v, want EACCES", err)
+		}
+	})
+}
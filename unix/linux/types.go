@@ -155,17 +155,21 @@ struct termios2 {
 #include <linux/random.h>
 #include <linux/rtc.h>
 #include <linux/rtnetlink.h>
+#include <linux/sched.h>
 // This is to avoid a conflict of struct sched_param being defined by
 // both the kernel and the glibc (sched.h) headers.
 #define sched_param kernel_sched_param
 #include <linux/sched/types.h>
 #undef kernel_sched_param
+#include <linux/seccomp.h>
 #include <linux/shm.h>
 #include <linux/sock_diag.h>
 #include <linux/socket.h>
 #include <linux/stat.h>
 #include <linux/taskstats.h>
 #include <linux/tipc.h>
+#include <linux/userfaultfd.h>
+#include <linux/veth.h>
 #include <linux/virtio_net.h>
 #include <linux/vm_sockets.h>
 #include <linux/watchdog.h>
@@ -274,6 +278,23 @@ struct sockaddr_rc {
 	uint8_t     rc_channel;
 };
 
+// copied from include/net/tcp_states.h in the kernel sources, which is not
+// part of the UAPI headers
+enum {
+	TCP_ESTABLISHED = 1,
+	TCP_SYN_SENT,
+	TCP_SYN_RECV,
+	TCP_FIN_WAIT1,
+	TCP_FIN_WAIT2,
+	TCP_TIME_WAIT,
+	TCP_CLOSE,
+	TCP_CLOSE_WAIT,
+	TCP_LAST_ACK,
+	TCP_LISTEN,
+	TCP_CLOSING,
+	TCP_NEW_SYN_RECV,
+};
+
 // copied from /usr/include/linux/un.h
 struct my_sockaddr_un {
 	sa_family_t sun_family;
@@ -527,6 +548,146 @@ struct cachestat {
 	__u64 nr_recently_evicted;
 };
 
+// Copied from <linux/io_uring.h> with the unions collapsed into their first
+// member, like perf_event_attr_go.
+struct io_uring_sqe_go {
+	__u8	opcode;
+	__u8	flags;
+	__u16	ioprio;
+	__s32	fd;
+	__u64	off;		// or addr2, cmd_op
+	__u64	addr;		// or splice_off_in
+	__u32	len;
+	__u32	op_flags;	// rw_flags, fsync_flags, msg_flags, accept_flags...
+	__u64	user_data;
+	__u16	buf_index;	// or buf_group
+	__u16	personality;
+	__s32	splice_fd_in;	// or file_index, addr_len
+	__u64	addr3;
+	__u64	__pad2[1];
+};
+
+// Copied from <linux/io_uring.h> without the big_cqe flexible array member
+// used by IORING_SETUP_CQE32 rings.
+struct io_uring_cqe_go {
+	__u64	user_data;
+	__s32	res;
+	__u32	flags;
+};
+
+// Copied from <linux/userfaultfd.h> with the arg union flattened into
+// words; use the UffdMsg accessors to decode it.
+struct uffd_msg_go {
+	__u8	event;
+	__u8	reserved1;
+	__u16	reserved2;
+	__u32	reserved3;
+	__u64	arg[3];
+};
+
+// Copied from <linux/bpf.h>, one struct for each member of union bpf_attr
+// used by the BPF wrappers. Unions inside them are collapsed into their
+// first member, like io_uring_sqe_go.
+struct bpf_map_create_attr_go {
+	__u32	map_type;
+	__u32	key_size;
+	__u32	value_size;
+	__u32	max_entries;
+	__u32	map_flags;
+	__u32	inner_map_fd;
+	__u32	numa_node;
+	__u8	map_name[BPF_OBJ_NAME_LEN];
+	__u32	map_ifindex;
+	__u32	btf_fd;
+	__u32	btf_key_type_id;
+	__u32	btf_value_type_id;
+	__u32	btf_vmlinux_value_type_id;
+	__u64	map_extra;
+};
+
+struct bpf_map_elem_attr_go {
+	__u32		map_fd;
+	__aligned_u64	key;
+	__aligned_u64	value;		// or next_key
+	__u64		flags;
+};
+
+struct bpf_map_batch_attr_go {
+	__aligned_u64	in_batch;
+	__aligned_u64	out_batch;
+	__aligned_u64	keys;
+	__aligned_u64	values;
+	__u32		count;
+	__u32		map_fd;
+	__u64		elem_flags;
+	__u64		flags;
+};
+
+struct bpf_prog_load_attr_go {
+	__u32		prog_type;
+	__u32		insn_cnt;
+	__aligned_u64	insns;
+	__aligned_u64	license;
+	__u32		log_level;
+	__u32		log_size;
+	__aligned_u64	log_buf;
+	__u32		kern_version;
+	__u32		prog_flags;
+	__u8		prog_name[BPF_OBJ_NAME_LEN];
+	__u32		prog_ifindex;
+	__u32		expected_attach_type;
+	__u32		prog_btf_fd;
+	__u32		func_info_rec_size;
+	__aligned_u64	func_info;
+	__u32		func_info_cnt;
+	__u32		line_info_rec_size;
+	__aligned_u64	line_info;
+	__u32		line_info_cnt;
+	__u32		attach_btf_id;
+	__u32		attach_prog_fd;	// or attach_btf_obj_fd
+	__u32		core_relo_cnt;
+	__aligned_u64	fd_array;
+	__aligned_u64	core_relos;
+	__u32		core_relo_rec_size;
+};
+
+struct bpf_obj_attr_go {
+	__aligned_u64	pathname;
+	__u32		bpf_fd;
+	__u32		file_flags;
+};
+
+struct bpf_link_create_attr_go {
+	__u32	prog_fd;	// or map_fd
+	__u32	target_fd;	// or target_ifindex
+	__u32	attach_type;
+	__u32	flags;
+	__u32	target_btf_id;
+};
+
+// struct bpf_insn with the dst_reg and src_reg bit fields merged into
+// regs, dst_reg in the low nibble on little-endian machines.
+struct bpf_insn_go {
+	__u8	code;
+	__u8	regs;
+	__s16	off;
+	__s32	imm;
+};
+
+// The __u32 array indexed by the SK_MEMINFO_* constants of
+// <linux/sock_diag.h> that SO_MEMINFO fills in.
+struct sock_meminfo_go {
+	__u32	rmem_alloc;
+	__u32	rcvbuf;
+	__u32	wmem_alloc;
+	__u32	sndbuf;
+	__u32	fwd_alloc;
+	__u32	wmem_queued;
+	__u32	optmem;
+	__u32	backlog;
+	__u32	drops;
+};
+
 // the one defined in linux/ptp_clock.h has unions
 struct my_ptp_perout_request {
 	struct ptp_clock_time startOrPhase;	// start or phase
@@ -958,6 +1119,8 @@ type IfAddrmsg C.struct_ifaddrmsg
 
 type IfaCacheinfo C.struct_ifa_cacheinfo
 
+type LinkStats64 C.struct_rtnl_link_stats64
+
 type RtMsg C.struct_rtmsg
 
 type RtNexthop C.struct_rtnexthop
@@ -1074,6 +1237,7 @@ const SizeofOpenHow = C.sizeof_struct_open_how
 
 const (
 	RESOLVE_BENEATH       = C.RESOLVE_BENEATH
+	RESOLVE_CACHED        = C.RESOLVE_CACHED
 	RESOLVE_IN_ROOT       = C.RESOLVE_IN_ROOT
 	RESOLVE_NO_MAGICLINKS = C.RESOLVE_NO_MAGICLINKS
 	RESOLVE_NO_SYMLINKS   = C.RESOLVE_NO_SYMLINKS
@@ -1110,6 +1274,8 @@ type SignalfdSiginfo C.struct_signalfd_siginfo
 
 type Siginfo C.siginfo_t
 
+type Stack_t C.stack_t
+
 // Terminal handling
 
 type Termios C.termios_t
@@ -1214,6 +1380,10 @@ type PerfEventAttr C.struct_perf_event_attr_go
 
 type PerfEventMmapPage C.struct_perf_event_mmap_page
 
+type PerfEventHeader C.struct_perf_event_header
+
+const SizeofPerfEventHeader = C.sizeof_struct_perf_event_header
+
 // Bit field in struct perf_event_attr expanded as flags.
 // Set these on PerfEventAttr.Bits by ORing them together.
 const (
@@ -2038,6 +2208,13 @@ const (
 	IFLA_DSA_MASTER                            = C.IFLA_DSA_MASTER
 )
 
+// veth
+// perl -nlE '/^\s*(VETH_INFO\w+)/ && say "$1 = C.$1"' /usr/include/linux/veth.h
+const (
+	VETH_INFO_UNSPEC = C.VETH_INFO_UNSPEC
+	VETH_INFO_PEER   = C.VETH_INFO_PEER
+)
+
 // netkit
 // perl -nlE '/^\s*(NETKIT\w+)/ && say "$1 = C.$1"' /usr/include/linux/if_link.h
 const (
@@ -3104,6 +3281,22 @@ const (
 	BPF_F_TIMER_ABS                            = C.BPF_F_TIMER_ABS
 )
 
+type BPFMapCreateAttr C.struct_bpf_map_create_attr_go
+
+type BPFMapElemAttr C.struct_bpf_map_elem_attr_go
+
+type BPFMapBatchAttr C.struct_bpf_map_batch_attr_go
+
+type BPFProgLoadAttr C.struct_bpf_prog_load_attr_go
+
+type BPFObjAttr C.struct_bpf_obj_attr_go
+
+type BPFLinkCreateAttr C.struct_bpf_link_create_attr_go
+
+type BPFInsn C.struct_bpf_insn_go
+
+const SizeofBPFInsn = C.sizeof_struct_bpf_insn_go
+
 // generated by:
 // perl -nlE '/^\s*(RTNLGRP_\w+)/ && say "$1 = C.$1"' include/uapi/linux/rtnetlink.h
 const (
@@ -4291,8 +4484,11 @@ type LandlockRulesetAttr C.struct_landlock_ruleset_attr
 
 type LandlockPathBeneathAttr C.struct_landlock_path_beneath_attr
 
+type LandlockNetPortAttr C.struct_landlock_net_port_attr
+
 const (
 	LANDLOCK_RULE_PATH_BENEATH = C.LANDLOCK_RULE_PATH_BENEATH
+	LANDLOCK_RULE_NET_PORT     = C.LANDLOCK_RULE_NET_PORT
 )
 
 // pidfd flags.
@@ -4330,6 +4526,17 @@ const (
 
 type MountAttr C.struct_mount_attr
 
+// statmount and listmount
+
+type MntIdReq C.struct_mnt_id_req
+
+type Statmount_t C.struct_statmount
+
+const (
+	SizeofMntIdReq  = C.sizeof_struct_mnt_id_req
+	SizeofStatmount = C.sizeof_struct_statmount
+)
+
 // WireGuard generic netlink interface
 
 // Generated by:
@@ -6068,6 +6275,8 @@ type SchedAttr C.struct_sched_attr
 
 const SizeofSchedAttr = C.sizeof_struct_sched_attr
 
+type CloneArgs C.struct_clone_args
+
 type Cachestat_t C.struct_cachestat
 type CachestatRange C.struct_cachestat_range
 
@@ -6099,7 +6308,181 @@ const (
 	SK_DIAG_BPF_STORAGE_MAP_VALUE  = C.SK_DIAG_BPF_STORAGE_MAP_VALUE
 )
 
+type SockMemInfo C.struct_sock_meminfo_go
+
+const SizeofSockMemInfo = C.sizeof_struct_sock_meminfo_go
+
 type SockDiagReq C.struct_sock_diag_req
 
+type InetDiagSockID C.struct_inet_diag_sockid
+
+type InetDiagReqV2 C.struct_inet_diag_req_v2
+
+type InetDiagMsg C.struct_inet_diag_msg
+
+const (
+	SizeofInetDiagReqV2 = C.sizeof_struct_inet_diag_req_v2
+	SizeofInetDiagMsg   = C.sizeof_struct_inet_diag_msg
+)
+
+// TCP connection states reported in InetDiagMsg.State
+
+const (
+	TCP_ESTABLISHED  = C.TCP_ESTABLISHED
+	TCP_SYN_SENT     = C.TCP_SYN_SENT
+	TCP_SYN_RECV     = C.TCP_SYN_RECV
+	TCP_FIN_WAIT1    = C.TCP_FIN_WAIT1
+	TCP_FIN_WAIT2    = C.TCP_FIN_WAIT2
+	TCP_TIME_WAIT    = C.TCP_TIME_WAIT
+	TCP_CLOSE        = C.TCP_CLOSE
+	TCP_CLOSE_WAIT   = C.TCP_CLOSE_WAIT
+	TCP_LAST_ACK     = C.TCP_LAST_ACK
+	TCP_LISTEN       = C.TCP_LISTEN
+	TCP_CLOSING      = C.TCP_CLOSING
+	TCP_NEW_SYN_RECV = C.TCP_NEW_SYN_RECV
+)
+
+// seccomp user notifications
+
+type SeccompData C.struct_seccomp_data
+
+type SeccompNotif C.struct_seccomp_notif
+
+type SeccompNotifResp C.struct_seccomp_notif_resp
+
+type SeccompNotifAddfd C.struct_seccomp_notif_addfd
+
+type SeccompNotifSizes C.struct_seccomp_notif_sizes
+
+const (
+	SizeofSeccompData       = C.sizeof_struct_seccomp_data
+	SizeofSeccompNotif      = C.sizeof_struct_seccomp_notif
+	SizeofSeccompNotifResp  = C.sizeof_struct_seccomp_notif_resp
+	SizeofSeccompNotifAddfd = C.sizeof_struct_seccomp_notif_addfd
+)
+
+// io_uring
+
+type IoUringParams C.struct_io_uring_params
+
+type IoSqringOffsets C.struct_io_sqring_offsets
+
+type IoCqringOffsets C.struct_io_cqring_offsets
+
+type IoUringSqe C.struct_io_uring_sqe_go
+
+type IoUringCqe C.struct_io_uring_cqe_go
+
+const (
+	SizeofIoUringParams = C.sizeof_struct_io_uring_params
+	SizeofIoUringSqe    = C.sizeof_struct_io_uring_sqe_go
+	SizeofIoUringCqe    = C.sizeof_struct_io_uring_cqe_go
+)
+
+// perl -nlE '/^\s*(IORING_(OP|REGISTER|UNREGISTER)_\w+)/ && say "$1 = C.$1"' /usr/include/linux/io_uring.h
+const (
+	IORING_OP_NOP                    = C.IORING_OP_NOP
+	IORING_OP_READV                  = C.IORING_OP_READV
+	IORING_OP_WRITEV                 = C.IORING_OP_WRITEV
+	IORING_OP_FSYNC                  = C.IORING_OP_FSYNC
+	IORING_OP_READ_FIXED             = C.IORING_OP_READ_FIXED
+	IORING_OP_WRITE_FIXED            = C.IORING_OP_WRITE_FIXED
+	IORING_OP_POLL_ADD               = C.IORING_OP_POLL_ADD
+	IORING_OP_POLL_REMOVE            = C.IORING_OP_POLL_REMOVE
+	IORING_OP_SYNC_FILE_RANGE        = C.IORING_OP_SYNC_FILE_RANGE
+	IORING_OP_SENDMSG                = C.IORING_OP_SENDMSG
+	IORING_OP_RECVMSG                = C.IORING_OP_RECVMSG
+	IORING_OP_TIMEOUT                = C.IORING_OP_TIMEOUT
+	IORING_OP_TIMEOUT_REMOVE         = C.IORING_OP_TIMEOUT_REMOVE
+	IORING_OP_ACCEPT                 = C.IORING_OP_ACCEPT
+	IORING_OP_ASYNC_CANCEL           = C.IORING_OP_ASYNC_CANCEL
+	IORING_OP_LINK_TIMEOUT           = C.IORING_OP_LINK_TIMEOUT
+	IORING_OP_CONNECT                = C.IORING_OP_CONNECT
+	IORING_OP_FALLOCATE              = C.IORING_OP_FALLOCATE
+	IORING_OP_OPENAT                 = C.IORING_OP_OPENAT
+	IORING_OP_CLOSE                  = C.IORING_OP_CLOSE
+	IORING_OP_FILES_UPDATE           = C.IORING_OP_FILES_UPDATE
+	IORING_OP_STATX                  = C.IORING_OP_STATX
+	IORING_OP_READ                   = C.IORING_OP_READ
+	IORING_OP_WRITE                  = C.IORING_OP_WRITE
+	IORING_OP_FADVISE                = C.IORING_OP_FADVISE
+	IORING_OP_MADVISE                = C.IORING_OP_MADVISE
+	IORING_OP_SEND                   = C.IORING_OP_SEND
+	IORING_OP_RECV                   = C.IORING_OP_RECV
+	IORING_OP_OPENAT2                = C.IORING_OP_OPENAT2
+	IORING_OP_EPOLL_CTL              = C.IORING_OP_EPOLL_CTL
+	IORING_OP_SPLICE                 = C.IORING_OP_SPLICE
+	IORING_OP_PROVIDE_BUFFERS        = C.IORING_OP_PROVIDE_BUFFERS
+	IORING_OP_REMOVE_BUFFERS         = C.IORING_OP_REMOVE_BUFFERS
+	IORING_OP_TEE                    = C.IORING_OP_TEE
+	IORING_OP_SHUTDOWN               = C.IORING_OP_SHUTDOWN
+	IORING_OP_RENAMEAT               = C.IORING_OP_RENAMEAT
+	IORING_OP_UNLINKAT               = C.IORING_OP_UNLINKAT
+	IORING_OP_MKDIRAT                = C.IORING_OP_MKDIRAT
+	IORING_OP_SYMLINKAT              = C.IORING_OP_SYMLINKAT
+	IORING_OP_LINKAT                 = C.IORING_OP_LINKAT
+	IORING_OP_MSG_RING               = C.IORING_OP_MSG_RING
+	IORING_OP_FSETXATTR              = C.IORING_OP_FSETXATTR
+	IORING_OP_SETXATTR               = C.IORING_OP_SETXATTR
+	IORING_OP_FGETXATTR              = C.IORING_OP_FGETXATTR
+	IORING_OP_GETXATTR               = C.IORING_OP_GETXATTR
+	IORING_OP_SOCKET                 = C.IORING_OP_SOCKET
+	IORING_OP_URING_CMD              = C.IORING_OP_URING_CMD
+	IORING_OP_SEND_ZC                = C.IORING_OP_SEND_ZC
+	IORING_OP_SENDMSG_ZC             = C.IORING_OP_SENDMSG_ZC
+	IORING_OP_LAST                   = C.IORING_OP_LAST
+	IORING_REGISTER_BUFFERS          = C.IORING_REGISTER_BUFFERS
+	IORING_UNREGISTER_BUFFERS        = C.IORING_UNREGISTER_BUFFERS
+	IORING_REGISTER_FILES            = C.IORING_REGISTER_FILES
+	IORING_UNREGISTER_FILES          = C.IORING_UNREGISTER_FILES
+	IORING_REGISTER_EVENTFD          = C.IORING_REGISTER_EVENTFD
+	IORING_UNREGISTER_EVENTFD        = C.IORING_UNREGISTER_EVENTFD
+	IORING_REGISTER_FILES_UPDATE     = C.IORING_REGISTER_FILES_UPDATE
+	IORING_REGISTER_EVENTFD_ASYNC    = C.IORING_REGISTER_EVENTFD_ASYNC
+	IORING_REGISTER_PROBE            = C.IORING_REGISTER_PROBE
+	IORING_REGISTER_PERSONALITY      = C.IORING_REGISTER_PERSONALITY
+	IORING_UNREGISTER_PERSONALITY    = C.IORING_UNREGISTER_PERSONALITY
+	IORING_REGISTER_RESTRICTIONS     = C.IORING_REGISTER_RESTRICTIONS
+	IORING_REGISTER_ENABLE_RINGS     = C.IORING_REGISTER_ENABLE_RINGS
+	IORING_REGISTER_FILES2           = C.IORING_REGISTER_FILES2
+	IORING_REGISTER_FILES_UPDATE2    = C.IORING_REGISTER_FILES_UPDATE2
+	IORING_REGISTER_BUFFERS2         = C.IORING_REGISTER_BUFFERS2
+	IORING_REGISTER_BUFFERS_UPDATE   = C.IORING_REGISTER_BUFFERS_UPDATE
+	IORING_REGISTER_IOWQ_AFF         = C.IORING_REGISTER_IOWQ_AFF
+	IORING_UNREGISTER_IOWQ_AFF       = C.IORING_UNREGISTER_IOWQ_AFF
+	IORING_REGISTER_IOWQ_MAX_WORKERS = C.IORING_REGISTER_IOWQ_MAX_WORKERS
+	IORING_REGISTER_RING_FDS         = C.IORING_REGISTER_RING_FDS
+	IORING_UNREGISTER_RING_FDS       = C.IORING_UNREGISTER_RING_FDS
+	IORING_REGISTER_PBUF_RING        = C.IORING_REGISTER_PBUF_RING
+	IORING_UNREGISTER_PBUF_RING      = C.IORING_UNREGISTER_PBUF_RING
+	IORING_REGISTER_SYNC_CANCEL      = C.IORING_REGISTER_SYNC_CANCEL
+	IORING_REGISTER_FILE_ALLOC_RANGE = C.IORING_REGISTER_FILE_ALLOC_RANGE
+)
+
+// userfaultfd
+
+type UffdioApi C.struct_uffdio_api
+
+type UffdioRange C.struct_uffdio_range
+
+type UffdioRegister C.struct_uffdio_register
+
+type UffdioCopy C.struct_uffdio_copy
+
+type UffdioZeropage C.struct_uffdio_zeropage
+
+type UffdioWriteprotect C.struct_uffdio_writeprotect
+
+type UffdioContinue C.struct_uffdio_continue
+
+type UffdMsg C.struct_uffd_msg_go
+
+const (
+	SizeofUffdioApi      = C.sizeof_struct_uffdio_api
+	SizeofUffdioRegister = C.sizeof_struct_uffdio_register
+	SizeofUffdioCopy     = C.sizeof_struct_uffdio_copy
+	SizeofUffdMsg        = C.sizeof_struct_uffd_msg_go
+)
+
 // Removed in Linux 6.13, kept for backwards compatibility.
 const RTM_NEWNVLAN = 0x70
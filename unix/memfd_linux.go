@@ -0,0 +1,82 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Helpers built on top of memfd_create(2).
+
+package unix
+
+import (
+	"os"
+	"strconv"
+)
+
+// MemfdCreateFile creates an anonymous memory-backed file with
+// memfd_create(2) and returns it as an *os.File. MFD_CLOEXEC is always
+// added to the MFD_* flags. The name, which need not be unique, is only
+// used for debugging: the file is named "/memfd:" followed by name, as in
+// its /proc/self/fd link.
+func MemfdCreateFile(name string, flags int) (*os.File, error) {
+	fd, err := MemfdCreate(name, flags|MFD_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+	return os.NewFile(uintptr(fd), "/memfd:"+name), nil
+}
+
+// memfdSeals is the full set of seals applied by SealedBlob. Once applied,
+// the contents and size of the memfd can no longer change and no further
+// seals can be added or removed.
+const memfdSeals = F_SEAL_WRITE | F_SEAL_SHRINK | F_SEAL_GROW | F_SEAL_SEAL
+
+// SealedBlob creates an anonymous memory-backed file named name, fills it
+// with data and seals it with F_SEAL_WRITE, F_SEAL_SHRINK, F_SEAL_GROW and
+// F_SEAL_SEAL.
+//
+// The returned file descriptor is effectively read-only: any attempt to
+// modify the contents through it, or through any other descriptor referring
+// to the same file, fails with EPERM. This makes it suitable for handing
+// configuration or other data to a child process that must not be able to
+// tamper with it. The descriptor is opened with MFD_CLOEXEC and its file
+// offset is at the start of the data.
+func SealedBlob(name string, data []byte) (fd int, err error) {
+	fd, err = MemfdCreate(name, MFD_CLOEXEC|MFD_ALLOW_SEALING)
+	if err != nil {
+		return -1, err
+	}
+	for off := 0; off < len(data); {
+		n, err := Pwrite(fd, data[off:], int64(off))
+		if err != nil {
+			Close(fd)
+			return -1, err
+		}
+		off += n
+	}
+	if _, err := FcntlInt(uintptr(fd), F_ADD_SEALS, memfdSeals); err != nil {
+		Close(fd)
+		return -1, err
+	}
+	return fd, nil
+}
+
+// ExecMemfd replaces the calling process with the program in code, an ELF
+// executable, without writing it to the file system. The program is copied
+// into a sealed memfd as by SealedBlob and executed with Execveat and
+// AT_EMPTY_PATH, or through its /proc/self/fd entry on kernels without
+// execveat. The argv and envv arguments are as for Exec. Like Exec,
+// ExecMemfd only returns on failure.
+//
+// The memfd is close-on-exec, so code must not be a script: the
+// interpreter would be unable to open the file.
+func ExecMemfd(code []byte, argv, envv []string) error {
+	fd, err := SealedBlob("exec", code)
+	if err != nil {
+		return err
+	}
+	err = Execveat(fd, "", argv, envv, AT_EMPTY_PATH)
+	if err == ENOSYS {
+		err = Exec("/proc/self/fd/"+strconv.Itoa(fd), argv, envv)
+	}
+	Close(fd)
+	return err
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestMemfdCreateFile(t *testing.T) {
+	f, err := unix.MemfdCreateFile("buffer", 0)
+	if err == unix.ENOSYS {
+		t.Skipf("memfd_create not supported: %v", err)
+	} else if err != nil {
+		t.Fatalf("MemfdCreateFile: %v", err)
+	}
+	defer f.Close()
+
+	if got, want := f.Name(), "/memfd:buffer"; got != want {
+		t.Errorf("Name: got %q, want %q", got, want)
+	}
+	link, err := os.Readlink(fmt.Sprintf("/proc/self/fd/%d", f.Fd()))
+	if err != nil {
+		t.Fatalf("Readlink: %v", err)
+	}
+	if want := "/memfd:buffer (deleted)"; link != want {
+		t.Errorf("fd link: got %q, want %q", link, want)
+	}
+	flags, err := unix.FcntlInt(f.Fd(), unix.F_GETFD, 0)
+	if err != nil {
+		t.Fatalf("FcntlInt: %v", err)
+	}
+	if flags&unix.FD_CLOEXEC == 0 {
+		t.Errorf("memfd is not close-on-exec")
+	}
+
+	want := []byte("data")
+	if _, err := f.WriteAt(want, 0); err != nil {
+		t.Fatalf("WriteAt: %v", err)
+	}
+	got := make([]byte, len(want))
+	if _, err := f.ReadAt(got, 0); err != nil {
+		t.Fatalf("ReadAt: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("ReadAt: got %q, want %q", got, want)
+	}
+}
+
+func TestSealedBlob(t *testing.T) {
+	want := []byte("key=value\n")
+	fd, err := unix.SealedBlob("config", want)
+	if err == unix.ENOSYS || err == unix.EINVAL {
+		t.Skipf("memfd sealing not supported: %v", err)
+	} else if err != nil {
+		t.Fatalf("SealedBlob: %v", err)
+	}
+	defer unix.Close(fd)
+
+	if _, err := unix.Write(fd, []byte("tampered")); err != unix.EPERM {
+		t.Errorf("Write to sealed blob: got %v, want %v", err, unix.EPERM)
+	}
+	if err := unix.Ftruncate(fd, 0); err != unix.EPERM {
+		t.Errorf("Ftruncate of sealed blob: got %v, want %v", err, unix.EPERM)
+	}
+
+	dupfd, err := unix.Dup(fd)
+	if err != nil {
+		t.Fatalf("Dup: %v", err)
+	}
+	defer unix.Close(dupfd)
+
+	got := make([]byte, len(want)+1)
+	n, err := unix.Read(dupfd, got)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if !bytes.Equal(got[:n], want) {
+		t.Errorf("Read: got %q, want %q", got[:n], want)
+	}
+}
+
+func TestExecMemfd(t *testing.T) {
+	switch os.Getenv("GO_WANT_HELPER_PROCESS") {
+	case "exec":
+		exe, err := os.Executable()
+		if err == nil {
+			var code []byte
+			if code, err = os.ReadFile(exe); err == nil {
+				err = unix.ExecMemfd(code, []string{exe, "-test.run=^TestExecMemfd$"}, execHelperEnv("exit"))
+			}
+		}
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	case "exit":
+		os.Exit(42)
+	}
+	runExecHelper(t, "TestExecMemfd")
+}
@@ -248,6 +248,7 @@ struct ltchars {
 #include <linux/if_packet.h>
 #include <linux/if_xdp.h>
 #include <linux/input.h>
+#include <linux/io_uring.h>
 #include <linux/kcm.h>
 #include <linux/kexec.h>
 #include <linux/keyctl.h>
@@ -274,11 +275,13 @@ struct ltchars {
 #include <linux/rtnetlink.h>
 #include <linux/sched.h>
 #include <linux/seccomp.h>
+#include <linux/securebits.h>
 #include <linux/serial.h>
 #include <linux/sock_diag.h>
 #include <linux/sockios.h>
 #include <linux/taskstats.h>
 #include <linux/tipc.h>
+#include <linux/userfaultfd.h>
 #include <linux/vm_sockets.h>
 #include <linux/wait.h>
 #include <linux/watchdog.h>
@@ -297,6 +300,10 @@ struct ltchars {
 #include <asm/termbits.h>
 #endif
 
+#if defined(__x86_64__)
+#include <asm/prctl.h>
+#endif
+
 #ifndef PTRACE_GETREGS
 #define PTRACE_GETREGS	0xc
 #endif
@@ -553,6 +560,7 @@ ccflags="$@"
 		$2 ~ /^SYSCTL_VERS/ ||
 		$2 !~ "MNT_BITS" &&
 		$2 ~ /^(MS|MNT|MOUNT|UMOUNT)_/ ||
+		$2 ~ /^(STATMOUNT|LISTMOUNT|LSMT)_/ ||
 		$2 ~ /^NS_GET_/ ||
 		$2 ~ /^TUN(SET|GET|ATTACH|DETACH)/ ||
 		$2 ~ /^(O|F|[ES]?FD|NAME|S|PTRACE|PT|PIOD|TFD)_/ ||
@@ -579,9 +587,11 @@ ccflags="$@"
 		$2 ~ /^RLIMIT_(AS|CORE|CPU|DATA|FSIZE|LOCKS|MEMLOCK|MSGQUEUE|NICE|NOFILE|NPROC|RSS|RTPRIO|RTTIME|SIGPENDING|STACK)|RLIM_INFINITY/ ||
 		$2 ~ /^PRIO_(PROCESS|PGRP|USER)/ ||
 		$2 ~ /^CLONE_[A-Z_]+/ ||
+		$2 ~ /^CLD_(EXITED|KILLED|DUMPED|TRAPPED|STOPPED|CONTINUED)$/ ||
 		$2 !~ /^(BPF_TIMEVAL|BPF_FIB_LOOKUP_[A-Z]+|BPF_F_LINK)$/ &&
 		$2 ~ /^(BPF|DLT)_/ ||
 		$2 ~ /^AUDIT_/ ||
+		$2 ~ /^ARCH_(SET|GET)_(FS|GS)$/ ||
 		$2 ~ /^(CLOCK|TIMER)_/ ||
 		$2 ~ /^CAN_/ ||
 		$2 ~ /^CAP_/ ||
@@ -603,12 +613,17 @@ ccflags="$@"
 		$2 ~ /^SECCOMP_/ ||
 		$2 ~ /^SEEK_/ ||
 		$2 ~ /^SCHED_/ ||
+		$2 ~ /^SECBIT_/ ||
 		$2 ~ /^SPLICE_/ ||
+		$2 ~ /^SI_(USER|KERNEL|QUEUE|TKILL)$/ ||
+		$2 ~ /^SS_(ONSTACK|DISABLE)$/ ||
 		$2 ~ /^SYNC_FILE_RANGE_/ ||
 		$2 !~ /IOC_MAGIC/ &&
 		$2 ~ /^[A-Z][A-Z0-9_]+_MAGIC2?$/ ||
 		$2 ~ /^(VM|VMADDR)_/ ||
 		$2 ~ /^IOCTL_VM_SOCKETS_/ ||
+		$2 ~ /^IORING_(SETUP|ENTER|FEAT|OFF|SQ|CQ|CQE_F|FSYNC|TIMEOUT)_/ ||
+		$2 ~ /^IOSQE_/ ||
 		$2 ~ /^(TASKSTATS|TS)_/ ||
 		$2 ~ /^CGROUPSTATS_/ ||
 		$2 ~ /^GENL_/ ||
@@ -616,6 +631,9 @@ ccflags="$@"
 		$2 ~ /^RENAME/ ||
 		$2 ~ /^UBI_IOC[A-Z]/ ||
 		$2 ~ /^UTIME_/ ||
+		$2 == "UFFD_API" ||
+		$2 ~ /^UFFD_(EVENT|PAGEFAULT_FLAG|FEATURE|USER_MODE)_/ ||
+		$2 ~ /^UFFDIO_/ ||
 		$2 ~ /^XATTR_(CREATE|REPLACE|NO(DEFAULT|FOLLOW|SECURITY)|SHOWCOMPRESSION)/ ||
 		$2 ~ /^ATTR_(BIT_MAP_COUNT|(CMN|VOL|FILE)_)/ ||
 		$2 ~ /^FSOPT_/ ||
@@ -0,0 +1,290 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Mount helpers.
+
+package unix
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// MountId returns the ID of the mount containing the file referred to by
+// fd. Two file descriptors with different mount IDs are on different mounts,
+// even if they share a file system. The ID matches the first field of
+// /proc/self/mountinfo.
+//
+// MountId uses statx(2) with STATX_MNT_ID where available (Linux >= 5.8) and
+// falls back to reading the mnt_id field of /proc/self/fdinfo/<fd>.
+func MountId(fd int) (int, error) {
+	var stx Statx_t
+	err := Statx(fd, "", AT_EMPTY_PATH, STATX_MNT_ID, &stx)
+	if err == nil && stx.Mask&STATX_MNT_ID != 0 {
+		return int(stx.Mnt_id), nil
+	}
+	if err != nil && err != ENOSYS {
+		return 0, err
+	}
+	return fdinfoInt(fd, "mnt_id")
+}
+
+// fdinfoInt returns the integer value of the given field in
+// /proc/self/fdinfo/<fd>.
+func fdinfoInt(fd int, field string) (int, error) {
+	b, err := os.ReadFile("/proc/self/fdinfo/" + strconv.Itoa(fd))
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		k, v, ok := strings.Cut(line, ":")
+		if ok && k == field {
+			return strconv.Atoi(strings.TrimSpace(v))
+		}
+	}
+	return 0, ENOENT
+}
+
+// IsolateMounts moves the calling thread into a new mount namespace and
+// makes every mount in it private, so that later mounts and unmounts
+// neither propagate back to the original namespace nor receive events from
+// it. It requires CAP_SYS_ADMIN.
+//
+// The new namespace applies to the calling thread only, so callers must
+// lock the goroutine to its thread with runtime.LockOSThread and leave it
+// locked; the runtime then discards the thread when the goroutine exits.
+func IsolateMounts() error {
+	if err := Unshare(CLONE_NEWNS); err != nil {
+		return err
+	}
+	return Mount("none", "/", "", MS_REC|MS_PRIVATE, "")
+}
+
+// FsContext is a filesystem context of the mount API, see fsopen(2). It is
+// configured with the Set methods and turned into a mount with Mount or
+// MountAt. When an operation fails, the kernel may explain why in a message
+// returned by Messages.
+type FsContext struct {
+	fd int
+}
+
+// NewFsContext creates a context for a new instance of the filesystem type
+// fsName, such as "tmpfs" or "ext4", with Fsopen. It requires
+// CAP_SYS_ADMIN, in the user namespace owning the caller's mount namespace
+// for filesystems that support it.
+func NewFsContext(fsName string) (*FsContext, error) {
+	fd, err := Fsopen(fsName, FSOPEN_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+	return &FsContext{fd: fd}, nil
+}
+
+// PickFsContext creates a context for reconfiguring the filesystem mounted
+// at path, relative to dirfd, with Fspick. The context is applied with
+// Reconfigure.
+func PickFsContext(dirfd int, path string) (*FsContext, error) {
+	fd, err := Fspick(dirfd, path, FSPICK_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+	return &FsContext{fd: fd}, nil
+}
+
+// Fd returns the file descriptor of the context.
+func (c *FsContext) Fd() int { return c.fd }
+
+// Close closes the context. Mounts created from it are not affected.
+func (c *FsContext) Close() error {
+	if c.fd < 0 {
+		return nil
+	}
+	err := Close(c.fd)
+	c.fd = -1
+	return err
+}
+
+// SetFlag sets the parameter key without a value, like "ro".
+func (c *FsContext) SetFlag(key string) error {
+	return FsconfigSetFlag(c.fd, key)
+}
+
+// SetString sets the parameter key to value, like "size" to "16m".
+func (c *FsContext) SetString(key, value string) error {
+	return FsconfigSetString(c.fd, key, value)
+}
+
+// SetPath sets the parameter key to the file at path, relative to dirfd,
+// like "source" to a block device.
+func (c *FsContext) SetPath(key, path string, dirfd int) error {
+	return FsconfigSetPath(c.fd, key, path, dirfd)
+}
+
+// SetFd sets the parameter key to the open file fd.
+func (c *FsContext) SetFd(key string, fd int) error {
+	return FsconfigSetFd(c.fd, key, fd)
+}
+
+// Mount creates the filesystem instance and returns a file descriptor
+// for a new detached mount of it, with the MOUNT_ATTR_* attributes in
+// attrs. Files can be created in it relative to the returned descriptor
+// with the *at system calls. The mount is attached to the file hierarchy
+// with MoveMount and MOVE_MOUNT_F_EMPTY_PATH, or released when the
+// descriptor is closed if it is never attached.
+func (c *FsContext) Mount(attrs int) (mountFd int, err error) {
+	if err := FsconfigCreate(c.fd); err != nil {
+		return -1, err
+	}
+	return Fsmount(c.fd, FSMOUNT_CLOEXEC, attrs)
+}
+
+// MountAt is like Mount, but attaches the new mount on top of path,
+// relative to dirfd.
+func (c *FsContext) MountAt(attrs int, dirfd int, path string) error {
+	mfd, err := c.Mount(attrs)
+	if err != nil {
+		return err
+	}
+	defer Close(mfd)
+	return MoveMount(mfd, "", dirfd, path, MOVE_MOUNT_F_EMPTY_PATH)
+}
+
+// Reconfigure applies the parameters set on a context created with
+// PickFsContext to the existing filesystem instance.
+func (c *FsContext) Reconfigure() error {
+	return FsconfigReconfigure(c.fd)
+}
+
+// Messages returns and clears the messages logged by the kernel for the
+// context, each prefixed with "e " for errors, "w " for warnings or "i "
+// for information, such as the reason a parameter was rejected.
+func (c *FsContext) Messages() []string {
+	var msgs []string
+	buf := make([]byte, 1024)
+	for {
+		n, err := Read(c.fd, buf)
+		if err != nil || n <= 0 {
+			return msgs
+		}
+		msgs = append(msgs, string(buf[:n]))
+	}
+}
+
+// IdmapMount returns a file descriptor for a new detached copy of the mount
+// at path, relative to dirfd, in which the owners of files are translated
+// with the ID mappings of the user namespace referred to by usernsFd, for
+// example an open /proc/<pid>/ns/user file: a file owned by ID n on disk
+// appears owned by the ID that n maps to, and files created through the
+// mount are stored with the reverse mapping. With recursive, the mounts
+// below path are copied as well. The mount is attached with MoveMount and
+// MOVE_MOUNT_F_EMPTY_PATH. It requires CAP_SYS_ADMIN, Linux >= 5.12 and a
+// file system supporting idmapped mounts.
+func IdmapMount(dirfd int, path string, usernsFd int, recursive bool) (mountFd int, err error) {
+	flags := uint(OPEN_TREE_CLONE | OPEN_TREE_CLOEXEC)
+	if recursive {
+		flags |= AT_RECURSIVE
+	}
+	fd, err := OpenTree(dirfd, path, flags)
+	if err != nil {
+		return -1, err
+	}
+	attr := MountAttr{Attr_set: MOUNT_ATTR_IDMAP, Userns_fd: uint64(usernsFd)}
+	if err := MountSetattr(fd, "", flags&AT_RECURSIVE|AT_EMPTY_PATH, &attr); err != nil {
+		Close(fd)
+		return -1, err
+	}
+	return fd, nil
+}
+
+// SetMountPropagation sets the propagation type of the mount at path,
+// relative to dirfd, to one of MS_SHARED, MS_SLAVE, MS_PRIVATE or
+// MS_UNBINDABLE with MountSetattr. With recursive, the mounts below path
+// are changed as well. An empty path refers to the mount dirfd is on, such
+// as a file descriptor returned by IdmapMount or FsContext.Mount.
+func SetMountPropagation(dirfd int, path string, propagation uint64, recursive bool) error {
+	var flags uint
+	if path == "" {
+		flags |= AT_EMPTY_PATH
+	}
+	if recursive {
+		flags |= AT_RECURSIVE
+	}
+	return MountSetattr(dirfd, path, flags, &MountAttr{Propagation: propagation})
+}
+
+// StatmountInfo is the information about a mount returned by Statmount.
+// The fields of Statmount_t and the strings are only set for the
+// STATMOUNT_* flags present in Mask.
+type StatmountInfo struct {
+	Statmount_t
+	FsType    string // with STATMOUNT_FS_TYPE, such as "ext4"
+	FsSubtype string // with STATMOUNT_FS_SUBTYPE, such as "sshfs" for "fuse"
+	Source    string // with STATMOUNT_SB_SOURCE, such as "/dev/sda1"
+	Root      string // with STATMOUNT_MNT_ROOT, the mounted directory of the file system
+	Point     string // with STATMOUNT_MNT_POINT, relative to the caller's root
+	Options   string // with STATMOUNT_MNT_OPTS, comma separated file system options
+}
+
+// Statmount returns the information selected by the STATMOUNT_* flags in
+// mask about the mount with the unique ID mntID, as returned by Listmount
+// or in Statx_t.Mnt_id with STATX_MNT_ID_UNIQUE, in the caller's mount
+// namespace. Sb_flags holds the MS_RDONLY, MS_SYNCHRONOUS, MS_DIRSYNC and
+// MS_LAZYTIME flags of the file system. It requires Linux >= 6.8.
+func Statmount(mntID uint64, mask uint64) (*StatmountInfo, error) {
+	req := MntIdReq{Size: SizeofMntIdReq, Mnt_id: mntID, Param: mask}
+	buf := make([]byte, 4096)
+	for {
+		err := statmount(&req, buf, 0)
+		if err == nil {
+			break
+		}
+		if err != EOVERFLOW || len(buf) >= 1<<20 {
+			return nil, err
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	info := &StatmountInfo{Statmount_t: *(*Statmount_t)(unsafe.Pointer(&buf[0]))}
+	str := buf[SizeofStatmount:min(int(info.Size), len(buf))]
+	for _, s := range []struct {
+		mask uint64
+		off  uint32
+		dst  *string
+	}{
+		{STATMOUNT_FS_TYPE, info.Fs_type, &info.FsType},
+		{STATMOUNT_FS_SUBTYPE, info.Fs_subtype, &info.FsSubtype},
+		{STATMOUNT_SB_SOURCE, info.Sb_source, &info.Source},
+		{STATMOUNT_MNT_ROOT, info.Mnt_root, &info.Root},
+		{STATMOUNT_MNT_POINT, info.Mnt_point, &info.Point},
+		{STATMOUNT_MNT_OPTS, info.Mnt_opts, &info.Options},
+	} {
+		if info.Mask&s.mask != 0 && int(s.off) < len(str) {
+			*s.dst = ByteSliceToString(str[s.off:])
+		}
+	}
+	return info, nil
+}
+
+// Listmount returns the unique IDs of the mounts directly below the mount
+// with the unique ID mntID, or of every mount in the caller's mount
+// namespace if mntID is LSMT_ROOT, in the order in which they were mounted
+// or in reverse with LISTMOUNT_REVERSE in flags. It requires Linux >= 6.8.
+func Listmount(mntID uint64, flags uint) ([]uint64, error) {
+	req := MntIdReq{Size: SizeofMntIdReq, Mnt_id: mntID}
+	var ids []uint64
+	buf := make([]uint64, 512)
+	for {
+		n, err := listmount(&req, buf, flags)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, buf[:n]...)
+		if n < len(buf) {
+			return ids, nil
+		}
+		// Continue after the last mount returned.
+		req.Param = buf[n-1]
+	}
+}
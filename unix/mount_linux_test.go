@@ -0,0 +1,351 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestMountId(t *testing.T) {
+	dir := t.TempDir()
+	var fds [2]int
+	for i := range fds {
+		name := filepath.Join(dir, "file"+string(rune('a'+i)))
+		if err := os.WriteFile(name, nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+		fd, err := unix.Open(name, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+		defer unix.Close(fd)
+		fds[i] = fd
+	}
+
+	id0, err := unix.MountId(fds[0])
+	if err != nil {
+		t.Fatalf("MountId: %v", err)
+	}
+	id1, err := unix.MountId(fds[1])
+	if err != nil {
+		t.Fatalf("MountId: %v", err)
+	}
+	if id0 != id1 {
+		t.Errorf("files in the same directory have different mount IDs %d and %d", id0, id1)
+	}
+
+	proc, err := unix.Open("/proc/self", unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer unix.Close(proc)
+	idProc, err := unix.MountId(proc)
+	if err != nil {
+		t.Fatalf("MountId: %v", err)
+	}
+	if idProc == id0 {
+		t.Errorf("/proc and %s have the same mount ID %d", dir, idProc)
+	}
+}
+
+func TestIsolateMounts(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") == "1" {
+		// A thread left in another mount namespace would confuse later
+		// tests that read /proc/self, so do the work in a child process.
+		runtime.LockOSThread()
+		src, dst := flag.Arg(0), flag.Arg(1)
+		if err := unix.IsolateMounts(); err != nil {
+			fmt.Printf("IsolateMounts: %v\n", err)
+			os.Exit(1)
+		}
+		if err := unix.Mount(src, dst, "", unix.MS_BIND, ""); err != nil {
+			fmt.Printf("bind mount: %v\n", err)
+			os.Exit(1)
+		}
+		if _, err := os.Stat(filepath.Join(dst, "marker")); err != nil {
+			fmt.Printf("bind mount not visible in the new namespace: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("ok")
+		// Keep the namespace alive while the parent looks.
+		io.Copy(io.Discard, os.Stdin)
+		os.Exit(0)
+	}
+	if os.Getuid() != 0 {
+		t.Skip("creating a mount namespace requires root")
+	}
+	src, dst := t.TempDir(), t.TempDir()
+	marker := filepath.Join(dst, "marker")
+	if err := os.WriteFile(filepath.Join(src, "marker"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(exe, "-test.run=^TestIsolateMounts$", "--", src, dst)
+	cmd.Env = append(os.Environ(), "GO_WANT_HELPER_PROCESS=1")
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Wait()
+	defer stdin.Close()
+
+	line, _ := bufio.NewReader(stdout).ReadString('\n')
+	if line = strings.TrimSpace(line); line != "ok" {
+		if strings.HasPrefix(line, "IsolateMounts: "+unix.EPERM.Error()) {
+			t.Skip(line)
+		}
+		t.Fatalf("child process: %q", line)
+	}
+	if _, err := os.Stat(marker); !os.IsNotExist(err) {
+		t.Errorf("bind mount leaked into the parent namespace: Stat(%q) = %v", marker, err)
+	}
+}
+
+// newTmpfsContext returns a tmpfs context, skipping the test if the mount
+// API is not available to the caller.
+func newTmpfsContext(t *testing.T) *unix.FsContext {
+	t.Helper()
+	c, err := unix.NewFsContext("tmpfs")
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("NewFsContext: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("NewFsContext: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestFsContextMount(t *testing.T) {
+	c := newTmpfsContext(t)
+	if err := c.SetString("bogus", "1"); err != unix.EINVAL {
+		t.Errorf("SetString of an unknown parameter: got %v, want EINVAL", err)
+	}
+	if msgs := c.Messages(); len(msgs) == 0 || !strings.Contains(msgs[0], "bogus") {
+		t.Errorf("Messages after an unknown parameter: got %q", msgs)
+	}
+	if err := c.SetString("size", "1m"); err != nil {
+		t.Fatalf("SetString: %v", err)
+	}
+	mfd, err := c.Mount(unix.MOUNT_ATTR_NOEXEC)
+	if err != nil {
+		t.Fatalf("Mount: %v %q", err, c.Messages())
+	}
+	defer unix.Close(mfd)
+
+	// The detached mount is usable through its file descriptor.
+	fd, err := unix.Openat(mfd, "file", unix.O_CREAT|unix.O_WRONLY|unix.O_CLOEXEC, 0o644)
+	if err != nil {
+		t.Fatalf("Openat in the detached mount: %v", err)
+	}
+	unix.Close(fd)
+	var st unix.Statfs_t
+	if err := unix.Fstatfs(mfd, &st); err != nil {
+		t.Fatalf("Fstatfs: %v", err)
+	}
+	if st.Type != unix.TMPFS_MAGIC {
+		t.Errorf("detached mount has file system type %#x, want TMPFS_MAGIC", st.Type)
+	}
+}
+
+func TestFsContextMountAt(t *testing.T) {
+	c := newTmpfsContext(t)
+	dir := t.TempDir()
+	if err := c.MountAt(unix.MOUNT_ATTR_RDONLY, unix.AT_FDCWD, dir); err != nil {
+		t.Fatalf("MountAt: %v %q", err, c.Messages())
+	}
+	defer unix.Unmount(dir, unix.MNT_DETACH)
+
+	var st unix.Statfs_t
+	if err := unix.Statfs(dir, &st); err != nil {
+		t.Fatalf("Statfs: %v", err)
+	}
+	if st.Type != unix.TMPFS_MAGIC || st.Flags&unix.ST_RDONLY == 0 {
+		t.Errorf("mount at %s: type %#x, flags %#x, want read-only tmpfs", dir, st.Type, st.Flags)
+	}
+
+	p, err := unix.PickFsContext(unix.AT_FDCWD, dir)
+	if err != nil {
+		t.Fatalf("PickFsContext: %v", err)
+	}
+	defer p.Close()
+	if err := p.SetString("size", "2m"); err != nil {
+		t.Fatalf("SetString: %v", err)
+	}
+	if err := p.Reconfigure(); err != nil {
+		t.Fatalf("Reconfigure: %v %q", err, p.Messages())
+	}
+}
+
+// userNamespace returns a file descriptor for a new user namespace mapping
+// IDs 0-999 inside to 100000-100999 outside, owned by a child process that
+// is killed when t finishes.
+func userNamespace(t *testing.T) int {
+	t.Helper()
+	cmd := exec.Command("sleep", "60")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags:  syscall.CLONE_NEWUSER,
+		UidMappings: []syscall.SysProcIDMap{{ContainerID: 0, HostID: 100000, Size: 1000}},
+		GidMappings: []syscall.SysProcIDMap{{ContainerID: 0, HostID: 100000, Size: 1000}},
+	}
+	if err := cmd.Start(); err != nil {
+		t.Skipf("cannot start a process in a user namespace: %v", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+	fd, err := unix.Open(fmt.Sprintf("/proc/%d/ns/user", cmd.Process.Pid), unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("opening the user namespace: %v", err)
+	}
+	t.Cleanup(func() { unix.Close(fd) })
+	return fd
+}
+
+func TestIdmapMount(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("idmapped mounts require root")
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "file"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chown(filepath.Join(dir, "file"), 7, 7); err != nil {
+		t.Fatal(err)
+	}
+	userns := userNamespace(t)
+
+	mfd, err := unix.IdmapMount(unix.AT_FDCWD, dir, userns, false)
+	if err == unix.ENOSYS || err == unix.EINVAL || err == unix.EPERM {
+		t.Skipf("IdmapMount: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("IdmapMount: %v", err)
+	}
+	defer unix.Close(mfd)
+
+	var st unix.Stat_t
+	if err := unix.Fstatat(mfd, "file", &st, 0); err != nil {
+		t.Fatalf("Fstatat: %v", err)
+	}
+	if st.Uid != 100007 || st.Gid != 100007 {
+		t.Errorf("owner in the idmapped mount: got %d:%d, want 100007:100007", st.Uid, st.Gid)
+	}
+
+	if err := unix.SetMountPropagation(mfd, "", unix.MS_PRIVATE, false); err != nil {
+		t.Errorf("SetMountPropagation: %v", err)
+	}
+	if err := unix.SetMountPropagation(mfd, "", unix.MS_PRIVATE|unix.MS_SHARED, false); err != unix.EINVAL {
+		t.Errorf("SetMountPropagation with two types: got %v, want EINVAL", err)
+	}
+}
+
+func TestIdmapMountRecursive(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("idmapped mounts require root")
+	}
+	// A bind mount of a subdirectory onto itself is a mount below dir.
+	dir := t.TempDir()
+	sub := filepath.Join(dir, "sub")
+	if err := os.Mkdir(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sub, "file"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chown(filepath.Join(sub, "file"), 7, 7); err != nil {
+		t.Fatal(err)
+	}
+	if err := unix.Mount(sub, sub, "", unix.MS_BIND, ""); err != nil {
+		t.Skipf("bind mount: %v", err)
+	}
+	defer unix.Unmount(sub, unix.MNT_DETACH)
+	userns := userNamespace(t)
+
+	mfd, err := unix.IdmapMount(unix.AT_FDCWD, dir, userns, true)
+	if err == unix.ENOSYS || err == unix.EINVAL || err == unix.EPERM {
+		t.Skipf("IdmapMount: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("IdmapMount: %v", err)
+	}
+	defer unix.Close(mfd)
+
+	var st unix.Stat_t
+	if err := unix.Fstatat(mfd, "sub/file", &st, 0); err != nil {
+		t.Fatalf("Fstatat: %v", err)
+	}
+	if st.Uid != 100007 || st.Gid != 100007 {
+		t.Errorf("owner in the idmapped submount: got %d:%d, want 100007:100007", st.Uid, st.Gid)
+	}
+}
+
+func TestStatmount(t *testing.T) {
+	ids, err := unix.Listmount(unix.LSMT_ROOT, 0)
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("Listmount: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("Listmount: %v", err)
+	}
+	if len(ids) == 0 {
+		t.Fatal("Listmount returned no mounts")
+	}
+	rev, err := unix.Listmount(unix.LSMT_ROOT, unix.LISTMOUNT_REVERSE)
+	if err != nil {
+		t.Fatalf("Listmount with LISTMOUNT_REVERSE: %v", err)
+	}
+	if len(rev) != len(ids) || rev[0] != ids[len(ids)-1] {
+		t.Errorf("Listmount with LISTMOUNT_REVERSE: got %v, want the reverse of %v", rev, ids)
+	}
+
+	var stx unix.Statx_t
+	if err := unix.Statx(unix.AT_FDCWD, "/proc", 0, unix.STATX_MNT_ID_UNIQUE, &stx); err != nil {
+		t.Fatalf("Statx: %v", err)
+	}
+	if stx.Mask&unix.STATX_MNT_ID_UNIQUE == 0 {
+		t.Skip("statx does not report unique mount IDs")
+	}
+	info, err := unix.Statmount(stx.Mnt_id, unix.STATMOUNT_SB_BASIC|unix.STATMOUNT_MNT_BASIC|
+		unix.STATMOUNT_FS_TYPE|unix.STATMOUNT_MNT_POINT|unix.STATMOUNT_MNT_OPTS)
+	if err != nil {
+		t.Fatalf("Statmount: %v", err)
+	}
+	if info.Mnt_id != stx.Mnt_id {
+		t.Errorf("Mnt_id: got %#x, want %#x", info.Mnt_id, stx.Mnt_id)
+	}
+	if info.FsType != "proc" || info.Sb_magic != unix.PROC_SUPER_MAGIC {
+		t.Errorf("file system of /proc: type %q, magic %#x", info.FsType, info.Sb_magic)
+	}
+	if info.Point != "/proc" {
+		t.Errorf("mount point of /proc: got %q", info.Point)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Helpers for namespaces, see namespaces(7).
+
+package unix
+
+import (
+	"errors"
+	"runtime"
+	"strconv"
+)
+
+// WithUTSNamespace runs fn with the calling thread in a new UTS namespace,
+// so that hostname and domain name changes made by fn, for instance with
+// Sethostname, are not seen by the rest of the system. The thread is moved
+// back to its original UTS namespace when fn returns. It requires
+// CAP_SYS_ADMIN.
+//
+// Namespaces apply to individual threads, so the calling goroutine must be
+// locked to its thread with runtime.LockOSThread. If moving back fails, its
+// error is returned, joined with the error of fn if any, and the goroutine
+// must remain locked.
+func WithUTSNamespace(fn func() error) (err error) {
+	orig, err := Open("/proc/thread-self/ns/uts", O_RDONLY|O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer Close(orig)
+	if err := Unshare(CLONE_NEWUTS); err != nil {
+		return err
+	}
+	defer func() {
+		if serr := Setns(orig, CLONE_NEWUTS); serr != nil {
+			err = errors.Join(err, serr)
+		}
+	}()
+	return fn()
+}
+
+// NewNetworkNamespace locks the calling goroutine to its thread and moves
+// the thread to a new network namespace, which starts out with only a
+// loopback interface that is down. Sockets and netlink requests made from
+// the thread then use the new namespace. It requires CAP_SYS_ADMIN.
+//
+// The returned restore function moves the thread back to its original
+// network namespace and unlocks the goroutine. If it fails, the goroutine
+// remains locked so that the thread, still in the new namespace, exits with
+// it instead of being reused.
+func NewNetworkNamespace() (restore func() error, err error) {
+	runtime.LockOSThread()
+	orig, err := Open("/proc/thread-self/ns/net", O_RDONLY|O_CLOEXEC, 0)
+	if err != nil {
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+	if err := Unshare(CLONE_NEWNET); err != nil {
+		Close(orig)
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+	restored := false
+	return func() error {
+		if restored {
+			return nil
+		}
+		if err := Setns(orig, CLONE_NEWNET); err != nil {
+			return err
+		}
+		restored = true
+		Close(orig)
+		runtime.UnlockOSThread()
+		return nil
+	}, nil
+}
+
+// NetnsInode returns the inode number of the network namespace of process
+// pid, or of the calling thread if pid is 0. Two processes are in the same
+// network namespace if and only if the inode numbers are equal. Reading
+// another process's namespace requires permission to ptrace it.
+func NetnsInode(pid int) (uint64, error) {
+	path := "/proc/thread-self/ns/net"
+	if pid < 0 {
+		return 0, EINVAL
+	} else if pid > 0 {
+		path = "/proc/" + strconv.Itoa(pid) + "/ns/net"
+	}
+	var st Stat_t
+	if err := Stat(path, &st); err != nil {
+		return 0, err
+	}
+	return st.Ino, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestWithUTSNamespace(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("creating a UTS namespace requires root")
+	}
+	orig, err := os.Hostname()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const name = "unix-test-uts"
+
+	runtime.LockOSThread()
+	var inner string
+	err = unix.WithUTSNamespace(func() error {
+		if err := unix.Sethostname([]byte(name)); err != nil {
+			return err
+		}
+		var uts unix.Utsname
+		if err := unix.Uname(&uts); err != nil {
+			return err
+		}
+		inner = unix.ByteSliceToString(uts.Nodename[:])
+		return nil
+	})
+	if err == nil {
+		runtime.UnlockOSThread()
+	}
+	if err == unix.EPERM {
+		t.Skip("WithUTSNamespace: permission denied")
+	}
+	if err != nil {
+		t.Fatalf("WithUTSNamespace: %v", err)
+	}
+	if inner != name {
+		t.Errorf("hostname inside the namespace = %q, want %q", inner, name)
+	}
+	if got, err := os.Hostname(); err != nil || got != orig {
+		t.Errorf("hostname after WithUTSNamespace = %q, %v; want %q", got, err, orig)
+	}
+}
+
+func TestWithUTSNamespaceError(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("creating a UTS namespace requires root")
+	}
+	runtime.LockOSThread()
+	orig, err := os.Readlink("/proc/thread-self/ns/uts")
+	if err != nil {
+		runtime.UnlockOSThread()
+		t.Fatal(err)
+	}
+	errFn := errors.New("fn failed")
+	var inner string
+	err = unix.WithUTSNamespace(func() error {
+		inner, _ = os.Readlink("/proc/thread-self/ns/uts")
+		return errFn
+	})
+	after, rerr := os.Readlink("/proc/thread-self/ns/uts")
+	if err == errFn && rerr == nil && after == orig {
+		runtime.UnlockOSThread()
+	}
+	if err == unix.EPERM {
+		t.Skip("WithUTSNamespace: permission denied")
+	}
+	if err != errFn {
+		t.Fatalf("WithUTSNamespace with a failing fn: got %v, want only the error of fn", err)
+	}
+	if inner == orig {
+		t.Errorf("fn ran in the original UTS namespace %s", orig)
+	}
+	if rerr != nil || after != orig {
+		t.Errorf("UTS namespace after WithUTSNamespace = %s, %v; want %s", after, rerr, orig)
+	}
+}
+
+func TestNetnsInode(t *testing.T) {
+	self, err := unix.NetnsInode(0)
+	if err != nil {
+		t.Fatalf("NetnsInode(0): %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		ino, err := unix.NetnsInode(unix.Getpid())
+		if err != nil {
+			t.Fatalf("NetnsInode(Getpid()): %v", err)
+		}
+		if ino != self {
+			t.Errorf("NetnsInode(Getpid()) = %d, NetnsInode(0) = %d", ino, self)
+		}
+	}
+	if _, err := unix.NetnsInode(-1); err != unix.EINVAL {
+		t.Errorf("NetnsInode(-1): got %v, want EINVAL", err)
+	}
+}
+
+func TestNewNetworkNamespace(t *testing.T) {
+	runNetnsHelper(t, func() error {
+		orig, err := unix.NetnsInode(0)
+		if err != nil {
+			return err
+		}
+		restore, err := unix.NewNetworkNamespace()
+		if err == unix.EPERM {
+			return fmt.Errorf("skip: NewNetworkNamespace: %v", err)
+		}
+		if err != nil {
+			return fmt.Errorf("NewNetworkNamespace: %v", err)
+		}
+		if ino, err := unix.NetnsInode(0); err != nil || ino == orig {
+			return fmt.Errorf("NetnsInode in the new namespace = %d, %v; want other than %d", ino, err, orig)
+		}
+		links, err := unix.LinkList()
+		if err != nil {
+			return fmt.Errorf("LinkList: %v", err)
+		}
+		if len(links) != 1 || links[0].Name != "lo" {
+			return fmt.Errorf("LinkList in the new namespace = %v, want only lo", links)
+		}
+		if err := restore(); err != nil {
+			return fmt.Errorf("restore: %v", err)
+		}
+		if ino, err := unix.NetnsInode(0); err != nil || ino != orig {
+			return fmt.Errorf("NetnsInode after restore = %d, %v; want %d", ino, err, orig)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Minimal netlink request handling for the sock_diag and rtnetlink
+// helpers, see netlink(7).
+
+package unix
+
+import "unsafe"
+
+func nlmsgAlign(n int) int { return (n + NLMSG_ALIGNTO - 1) &^ (NLMSG_ALIGNTO - 1) }
+
+func rtaAlign(n int) int { return (n + RTA_ALIGNTO - 1) &^ (RTA_ALIGNTO - 1) }
+
+// netlinkRequest sends a single request message of type typ with the given
+// flags and payload on a new netlink socket of protocol proto, and returns
+// the payloads of the messages received in reply. A dump request collects
+// every part of the multipart reply up to NLMSG_DONE; any other request
+// should set NLM_F_ACK so that the kernel confirms it. An error reported by
+// the kernel is returned as an Errno.
+func netlinkRequest(proto int, typ, flags uint16, data []byte) ([][]byte, error) {
+	fd, err := Socket(AF_NETLINK, SOCK_RAW|SOCK_CLOEXEC, proto)
+	if err != nil {
+		return nil, err
+	}
+	defer Close(fd)
+	sa := &SockaddrNetlink{Family: AF_NETLINK}
+	if err := Bind(fd, sa); err != nil {
+		return nil, err
+	}
+
+	const seq = 1
+	req := make([]byte, NLMSG_HDRLEN+len(data))
+	*(*NlMsghdr)(unsafe.Pointer(&req[0])) = NlMsghdr{
+		Len:   uint32(len(req)),
+		Type:  typ,
+		Flags: NLM_F_REQUEST | flags,
+		Seq:   seq,
+	}
+	copy(req[NLMSG_HDRLEN:], data)
+	if err := Sendto(fd, req, 0, sa); err != nil {
+		return nil, err
+	}
+
+	var msgs [][]byte
+	buf := make([]byte, 1<<16)
+	for {
+		n, _, err := Recvfrom(fd, buf, 0)
+		if err != nil {
+			if err == EINTR {
+				continue
+			}
+			return nil, err
+		}
+		b := buf[:n]
+		multi := false
+		for len(b) >= NLMSG_HDRLEN {
+			h := (*NlMsghdr)(unsafe.Pointer(&b[0]))
+			if h.Len < NLMSG_HDRLEN || int(h.Len) > len(b) {
+				return nil, EINVAL
+			}
+			payload := b[NLMSG_HDRLEN:h.Len]
+			b = b[min(nlmsgAlign(int(h.Len)), len(b)):]
+			if h.Seq != seq {
+				continue
+			}
+			multi = h.Flags&NLM_F_MULTI != 0
+			switch h.Type {
+			case NLMSG_DONE:
+				if len(payload) >= 4 {
+					if e := *(*int32)(unsafe.Pointer(&payload[0])); e < 0 {
+						return nil, Errno(-e)
+					}
+				}
+				return msgs, nil
+			case NLMSG_ERROR:
+				if len(payload) < 4 {
+					return nil, EINVAL
+				}
+				if e := (*NlMsgerr)(unsafe.Pointer(&payload[0])).Error; e < 0 {
+					return nil, Errno(-e)
+				}
+				return msgs, nil
+			case NLMSG_NOOP:
+			default:
+				msgs = append(msgs, append([]byte(nil), payload...))
+			}
+		}
+		if !multi {
+			return msgs, nil
+		}
+	}
+}
+
+// netlinkAttr is a netlink attribute, a type-length-value triplet.
+type netlinkAttr struct {
+	Type  uint16
+	Value []byte
+}
+
+// parseNetlinkAttrs splits b into the attributes it contains. The
+// NLA_F_NESTED and NLA_F_NET_BYTEORDER flags are cleared from the types.
+func parseNetlinkAttrs(b []byte) ([]netlinkAttr, error) {
+	var attrs []netlinkAttr
+	for len(b) >= SizeofRtAttr {
+		a := (*RtAttr)(unsafe.Pointer(&b[0]))
+		if a.Len < SizeofRtAttr || int(a.Len) > len(b) {
+			return nil, EINVAL
+		}
+		attrs = append(attrs, netlinkAttr{
+			Type:  a.Type &^ (NLA_F_NESTED | NLA_F_NET_BYTEORDER),
+			Value: b[SizeofRtAttr:a.Len],
+		})
+		b = b[min(rtaAlign(int(a.Len)), len(b)):]
+	}
+	return attrs, nil
+}
+
+// appendNetlinkAttr appends an attribute of type typ holding value to b,
+// padded to the attribute alignment.
+func appendNetlinkAttr(b []byte, typ uint16, value []byte) []byte {
+	hdr := RtAttr{Len: uint16(SizeofRtAttr + len(value)), Type: typ}
+	b = append(b, (*[SizeofRtAttr]byte)(unsafe.Pointer(&hdr))[:]...)
+	b = append(b, value...)
+	return append(b, make([]byte, rtaAlign(len(value))-len(value))...)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Performance monitoring with perf events, see perf_event_open(2).
+
+package unix
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// IoctlPerfEventEnable enables the perf event fd, or with
+// PERF_IOC_FLAG_GROUP in flags all the events of its group.
+func IoctlPerfEventEnable(fd int, flags int) error {
+	return ioctl(fd, PERF_EVENT_IOC_ENABLE, uintptr(flags))
+}
+
+// IoctlPerfEventDisable disables the perf event fd, or with
+// PERF_IOC_FLAG_GROUP in flags all the events of its group.
+func IoctlPerfEventDisable(fd int, flags int) error {
+	return ioctl(fd, PERF_EVENT_IOC_DISABLE, uintptr(flags))
+}
+
+// IoctlPerfEventReset resets the count of the perf event fd to zero, or
+// with PERF_IOC_FLAG_GROUP in flags of all the events of its group.
+func IoctlPerfEventReset(fd int, flags int) error {
+	return ioctl(fd, PERF_EVENT_IOC_RESET, uintptr(flags))
+}
+
+// IoctlPerfEventID returns the unique ID of the perf event fd, as found in
+// records with PERF_SAMPLE_ID or PERF_SAMPLE_IDENTIFIER and in the values
+// read with PERF_FORMAT_ID.
+func IoctlPerfEventID(fd int) (uint64, error) {
+	var id uint64
+	err := ioctlPtr(fd, PERF_EVENT_IOC_ID, unsafe.Pointer(&id))
+	return id, err
+}
+
+// PerfRing is the ring buffer of a sampling perf event mapped into memory,
+// from which the kernel-written records are read with ReadRecord. The
+// kernel makes the event file descriptor readable, as reported by Poll,
+// according to the Wakeup field of its PerfEventAttr.
+//
+// A PerfRing must not be used concurrently from multiple goroutines.
+type PerfRing struct {
+	mem  []byte
+	meta *PerfEventMmapPage
+	data []byte
+	tail uint64
+}
+
+// NewPerfRing maps the ring buffer of the perf event fd with pages data
+// pages, which must be a power of two, plus the metadata page. The file
+// descriptor remains owned by the caller and may be closed once the ring
+// has been mapped.
+func NewPerfRing(fd int, pages int) (*PerfRing, error) {
+	if pages <= 0 || pages&(pages-1) != 0 {
+		return nil, EINVAL
+	}
+	pageSize := Getpagesize()
+	mem, err := Mmap(fd, 0, (1+pages)*pageSize, PROT_READ|PROT_WRITE, MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+	meta := (*PerfEventMmapPage)(unsafe.Pointer(&mem[0]))
+	off, size := uint64(pageSize), uint64(pages*pageSize)
+	if meta.Data_size != 0 {
+		// Linux >= 4.1 reports the location of the data area.
+		off, size = meta.Data_offset, meta.Data_size
+	}
+	return &PerfRing{
+		mem:  mem,
+		meta: meta,
+		data: mem[off : off+size],
+		tail: atomic.LoadUint64(&meta.Data_tail),
+	}, nil
+}
+
+// Close unmaps the ring buffer.
+func (r *PerfRing) Close() error {
+	if r.mem == nil {
+		return nil
+	}
+	err := Munmap(r.mem)
+	r.mem, r.meta, r.data = nil, nil, nil
+	return err
+}
+
+// Meta returns the metadata page of the ring buffer, which also holds the
+// information needed to read the event count from user space.
+func (r *PerfRing) Meta() *PerfEventMmapPage { return r.meta }
+
+// ReadRecord removes the next record from the ring buffer and returns its
+// header and the data following the header, appended to buf[:0]. It
+// returns ok false if the ring buffer is empty. The layout of the data
+// depends on hdr.Type, one of the PERF_RECORD_* constants, and for
+// PERF_RECORD_SAMPLE on the Sample_type of the event.
+func (r *PerfRing) ReadRecord(buf []byte) (hdr PerfEventHeader, data []byte, ok bool) {
+	head := atomic.LoadUint64(&r.meta.Data_head)
+	if head-r.tail < SizeofPerfEventHeader {
+		return PerfEventHeader{}, buf[:0], false
+	}
+	var h [SizeofPerfEventHeader]byte
+	r.copyOut(h[:], r.tail)
+	hdr = *(*PerfEventHeader)(unsafe.Pointer(&h[0]))
+	size := uint64(hdr.Size)
+	if size < SizeofPerfEventHeader || head-r.tail < size {
+		// Not a valid record, give up on the whole buffer.
+		r.tail = head
+		atomic.StoreUint64(&r.meta.Data_tail, r.tail)
+		return PerfEventHeader{}, buf[:0], false
+	}
+	n := int(size - SizeofPerfEventHeader)
+	if cap(buf) < n {
+		buf = make([]byte, n)
+	}
+	data = buf[:n]
+	r.copyOut(data, r.tail+SizeofPerfEventHeader)
+	r.tail += size
+	// Hand the space back to the kernel only after the record is copied.
+	atomic.StoreUint64(&r.meta.Data_tail, r.tail)
+	return hdr, data, true
+}
+
+// copyOut copies len(p) bytes starting at the ring position pos, which
+// wraps around the end of the data area.
+func (r *PerfRing) copyOut(p []byte, pos uint64) {
+	off := pos & uint64(len(r.data)-1)
+	n := copy(p, r.data[off:])
+	copy(p[n:], r.data)
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"encoding/binary"
+	"runtime"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// perfEventOpen opens a disabled software event of the calling thread,
+// skipping the test if perf events are not available.
+func perfEventOpen(t *testing.T, attr *unix.PerfEventAttr) int {
+	t.Helper()
+	attr.Type = unix.PERF_TYPE_SOFTWARE
+	attr.Size = uint32(unsafe.Sizeof(*attr))
+	attr.Bits |= unix.PerfBitDisabled | unix.PerfBitExcludeKernel | unix.PerfBitExcludeHv
+	fd, err := unix.PerfEventOpen(attr, 0, -1, -1, unix.PERF_FLAG_FD_CLOEXEC)
+	if err == unix.ENOSYS || err == unix.EACCES || err == unix.EPERM || err == unix.ENOENT {
+		t.Skipf("perf events not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("PerfEventOpen: %v", err)
+	}
+	t.Cleanup(func() { unix.Close(fd) })
+	return fd
+}
+
+// spin keeps the calling thread busy for d.
+func spin(d time.Duration) {
+	for start := time.Now(); time.Since(start) < d; {
+	}
+}
+
+func TestPerfEventIoctl(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	fd := perfEventOpen(t, &unix.PerfEventAttr{Config: unix.PERF_COUNT_SW_TASK_CLOCK})
+
+	if id, err := unix.IoctlPerfEventID(fd); err != nil || id == 0 {
+		t.Errorf("IoctlPerfEventID = %d, %v", id, err)
+	}
+	if err := unix.IoctlPerfEventEnable(fd, 0); err != nil {
+		t.Fatalf("IoctlPerfEventEnable: %v", err)
+	}
+	spin(10 * time.Millisecond)
+	if err := unix.IoctlPerfEventDisable(fd, 0); err != nil {
+		t.Fatalf("IoctlPerfEventDisable: %v", err)
+	}
+	count := func() uint64 {
+		var b [8]byte
+		if _, err := unix.Read(fd, b[:]); err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+		return binary.NativeEndian.Uint64(b[:])
+	}
+	if n := count(); n < uint64(time.Millisecond) {
+		t.Errorf("task clock after spinning 10ms: %dns", n)
+	}
+	if err := unix.IoctlPerfEventReset(fd, 0); err != nil {
+		t.Fatalf("IoctlPerfEventReset: %v", err)
+	}
+	if n := count(); n != 0 {
+		t.Errorf("task clock after reset: %dns, want 0", n)
+	}
+}
+
+func TestPerfRing(t *testing.T) {
+	if _, err := unix.NewPerfRing(-1, 3); err != unix.EINVAL {
+		t.Errorf("NewPerfRing with 3 pages: got %v, want EINVAL", err)
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	fd := perfEventOpen(t, &unix.PerfEventAttr{
+		Config:      unix.PERF_COUNT_SW_CPU_CLOCK,
+		Sample:      uint64(100 * time.Microsecond), // sample period in ns
+		Sample_type: unix.PERF_SAMPLE_TID,
+		Wakeup:      1,
+	})
+	r, err := unix.NewPerfRing(fd, 1)
+	if err != nil {
+		t.Fatalf("NewPerfRing: %v", err)
+	}
+	defer r.Close()
+
+	// Sample in several rounds, draining the ring after each, so that more
+	// records go through it than it can hold and it wraps around.
+	var samples int
+	var buf []byte
+	for i := 0; i < 4; i++ {
+		if err := unix.IoctlPerfEventEnable(fd, 0); err != nil {
+			t.Fatalf("IoctlPerfEventEnable: %v", err)
+		}
+		spin(10 * time.Millisecond)
+		if err := unix.IoctlPerfEventDisable(fd, 0); err != nil {
+			t.Fatalf("IoctlPerfEventDisable: %v", err)
+		}
+		for {
+			hdr, data, ok := r.ReadRecord(buf)
+			if !ok {
+				break
+			}
+			buf = data
+			if hdr.Type != unix.PERF_RECORD_SAMPLE {
+				continue
+			}
+			if len(data) != 8 {
+				t.Fatalf("sample record of %d bytes, want 8", len(data))
+			}
+			pid, tid := binary.NativeEndian.Uint32(data), binary.NativeEndian.Uint32(data[4:])
+			if int(pid) != unix.Getpid() || int(tid) != unix.Gettid() {
+				t.Errorf("sample for %d/%d, want %d/%d", pid, tid, unix.Getpid(), unix.Gettid())
+			}
+			samples++
+		}
+	}
+	if samples == 0 {
+		t.Errorf("no samples after spinning 40ms")
+	}
+	if head, tail := r.Meta().Data_head, r.Meta().Data_tail; head != tail {
+		t.Errorf("ring not drained: head %d, tail %d", head, tail)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Process group membership read from procfs.
+
+package unix
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ProcessGroupMembers returns the IDs of the processes in process group
+// pgid, in ascending order, by scanning /proc. Processes in other PID
+// namespaces are not seen. The result is a snapshot: processes may join or
+// leave the group at any time.
+func ProcessGroupMembers(pgid int) ([]int, error) {
+	if pgid <= 0 {
+		return nil, EINVAL
+	}
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+	var pids []int
+	for _, e := range entries {
+		pid, err := strconv.Atoi(e.Name())
+		if err != nil {
+			continue
+		}
+		b, err := os.ReadFile("/proc/" + e.Name() + "/stat")
+		if err != nil {
+			// The process exited after the directory was read.
+			continue
+		}
+		if pg, ok := statPgrp(string(b)); ok && pg == pgid {
+			pids = append(pids, pid)
+		}
+	}
+	sort.Ints(pids)
+	return pids, nil
+}
+
+// statPgrp returns the process group field of a /proc/<pid>/stat line.
+func statPgrp(stat string) (int, bool) {
+	// The command name is in parentheses and may itself contain spaces
+	// and parentheses, so start after the last ')'. It is followed by
+	// the state, the parent pid and the process group.
+	i := strings.LastIndexByte(stat, ')')
+	if i < 0 {
+		return 0, false
+	}
+	f := strings.Fields(stat[i+1:])
+	if len(f) < 3 {
+		return 0, false
+	}
+	pgrp, err := strconv.Atoi(f[2])
+	return pgrp, err == nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestProcessGroupMembers(t *testing.T) {
+	pgid, err := unix.Getpgid(0)
+	if err != nil {
+		t.Fatalf("Getpgid: %v", err)
+	}
+	if pgrp := unix.Getpgrp(); pgid != pgrp {
+		t.Errorf("Getpgid(0) = %d, Getpgrp() = %d", pgid, pgrp)
+	}
+	if _, err := unix.Getsid(0); err != nil {
+		t.Errorf("Getsid: %v", err)
+	}
+
+	pids, err := unix.ProcessGroupMembers(pgid)
+	if err != nil {
+		t.Fatalf("ProcessGroupMembers: %v", err)
+	}
+	if !slices.Contains(pids, unix.Getpid()) {
+		t.Errorf("ProcessGroupMembers(%d) = %v, missing the current process %d", pgid, pids, unix.Getpid())
+	}
+	if !slices.IsSorted(pids) {
+		t.Errorf("ProcessGroupMembers(%d) = %v, not sorted", pgid, pids)
+	}
+	if _, err := unix.ProcessGroupMembers(0); err != unix.EINVAL {
+		t.Errorf("ProcessGroupMembers(0): got %v, want EINVAL", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Helpers for process file descriptors, see pidfd_open(2).
+
+package unix
+
+import "time"
+
+// WaitPidfd waits up to timeout for the process referred to by pidfd to
+// exit, by polling pidfd for POLLIN, and reports whether it has exited. A
+// negative timeout waits indefinitely and a zero timeout only checks the
+// current state. The process is not reaped; use Waitid with P_PIDFD to
+// collect its exit status.
+func WaitPidfd(pidfd int, timeout time.Duration) (exited bool, err error) {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	for {
+		ms := -1
+		if timeout >= 0 {
+			ms = 0
+			if timeout > 0 {
+				// Round up so that we never wake up early.
+				ms = int((time.Until(deadline) + time.Millisecond - 1) / time.Millisecond)
+				if ms < 0 {
+					ms = 0
+				}
+			}
+		}
+		fds := []PollFd{{Fd: int32(pidfd), Events: POLLIN}}
+		n, err := Poll(fds, ms)
+		if err == EINTR {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if n > 0 && fds[0].Revents&POLLNVAL != 0 {
+			return false, EBADF
+		}
+		return n > 0 && fds[0].Revents&(POLLIN|POLLHUP) != 0, nil
+	}
+}
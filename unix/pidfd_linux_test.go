@@ -0,0 +1,175 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestWaitPidfd(t *testing.T) {
+	cmd := exec.Command("sleep", "0.2")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("cannot start child: %v", err)
+	}
+	defer cmd.Wait()
+	pidfd, err := unix.PidfdOpen(cmd.Process.Pid, 0)
+	if err == unix.ENOSYS {
+		t.Skip("pidfd_open not supported")
+	}
+	if err != nil {
+		t.Fatalf("PidfdOpen: %v", err)
+	}
+	defer unix.Close(pidfd)
+
+	exited, err := unix.WaitPidfd(pidfd, 0)
+	if err != nil {
+		t.Fatalf("WaitPidfd: %v", err)
+	}
+	if exited {
+		t.Fatal("WaitPidfd reports exit of a running child")
+	}
+
+	start := time.Now()
+	exited, err = unix.WaitPidfd(pidfd, 10*time.Second)
+	if err != nil {
+		t.Fatalf("WaitPidfd: %v", err)
+	}
+	if !exited {
+		t.Fatal("WaitPidfd timed out waiting for the child")
+	}
+	t.Logf("child exited after %v", time.Since(start))
+
+	// The process is not reaped, so waiting again returns at once.
+	if exited, err := unix.WaitPidfd(pidfd, -1); err != nil || !exited {
+		t.Errorf("second WaitPidfd = %v, %v, want true, nil", exited, err)
+	}
+}
+
+func TestWaitidChild(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "exit 3")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("cannot start child: %v", err)
+	}
+	pidfd, err := unix.PidfdOpen(cmd.Process.Pid, 0)
+	if err == unix.ENOSYS {
+		cmd.Wait()
+		t.Skip("pidfd_open not supported")
+	}
+	if err != nil {
+		cmd.Wait()
+		t.Fatalf("PidfdOpen: %v", err)
+	}
+	defer unix.Close(pidfd)
+
+	info, err := unix.WaitidChild(unix.P_PIDFD, pidfd, unix.WEXITED, nil)
+	if err != nil {
+		t.Fatalf("WaitidChild: %v", err)
+	}
+	want := unix.SiginfoChild{Pid: cmd.Process.Pid, Uid: unix.Getuid(), Code: unix.CLD_EXITED, Status: 3}
+	if info != want {
+		t.Errorf("WaitidChild: got %+v, want %+v", info, want)
+	}
+}
+
+func TestWaitidChildKilled(t *testing.T) {
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("cannot start child: %v", err)
+	}
+	pidfd, err := unix.PidfdOpen(cmd.Process.Pid, 0)
+	if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		t.Skipf("PidfdOpen: %v", err)
+	}
+	defer unix.Close(pidfd)
+
+	info, err := unix.WaitidChild(unix.P_PIDFD, pidfd, unix.WEXITED|unix.WNOHANG, nil)
+	if err != nil || info.Pid != 0 {
+		t.Errorf("WaitidChild with WNOHANG on a running child: got %+v, %v", info, err)
+	}
+	if err := unix.PidfdSendSignal(pidfd, unix.SIGKILL, nil, 0); err != nil {
+		t.Fatalf("PidfdSendSignal: %v", err)
+	}
+	info, err = unix.WaitidChild(unix.P_PIDFD, pidfd, unix.WEXITED, nil)
+	if err != nil {
+		t.Fatalf("WaitidChild: %v", err)
+	}
+	if info.Pid != cmd.Process.Pid || info.Code != unix.CLD_KILLED || info.Status != int(unix.SIGKILL) {
+		t.Errorf("WaitidChild: got %+v, want child %d killed by SIGKILL", info, cmd.Process.Pid)
+	}
+}
+
+func TestProcessMadvise(t *testing.T) {
+	pidfd, err := unix.PidfdOpen(unix.Getpid(), 0)
+	if err != nil {
+		t.Skipf("PidfdOpen: %v", err)
+	}
+	defer unix.Close(pidfd)
+
+	size := 4 * unix.Getpagesize()
+	mem, err := unix.Mmap(-1, 0, size, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANONYMOUS)
+	if err != nil {
+		t.Fatalf("Mmap: %v", err)
+	}
+	defer unix.Munmap(mem)
+	for i := range mem {
+		mem[i] = 1
+	}
+
+	iovs := []unix.RemoteIovec{{Base: uintptr(unsafe.Pointer(&mem[0])), Len: size}}
+	n, err := unix.ProcessMadvise(pidfd, iovs, unix.MADV_COLD, 0)
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("process_madvise not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("ProcessMadvise: %v", err)
+	}
+	if n != size {
+		t.Errorf("ProcessMadvise: advised %d bytes, want %d", n, size)
+	}
+	if _, err := unix.ProcessMadvise(pidfd, iovs, unix.MADV_COLD, 1); err != unix.EINVAL {
+		t.Errorf("ProcessMadvise with unknown flags: got %v, want EINVAL", err)
+	}
+	if mem[size-1] != 1 {
+		t.Errorf("MADV_COLD changed the memory contents")
+	}
+}
+
+func TestProcessMrelease(t *testing.T) {
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("cannot start child: %v", err)
+	}
+	defer cmd.Wait()
+	pidfd, err := unix.PidfdOpen(cmd.Process.Pid, 0)
+	if err != nil {
+		cmd.Process.Kill()
+		t.Skipf("PidfdOpen: %v", err)
+	}
+	defer unix.Close(pidfd)
+
+	err = unix.ProcessMrelease(pidfd, 0)
+	if err == unix.ENOSYS {
+		cmd.Process.Kill()
+		t.Skipf("process_mrelease not available: %v", err)
+	}
+	if err != unix.EINVAL {
+		t.Errorf("ProcessMrelease on a running process: got %v, want EINVAL", err)
+	}
+	if err := unix.PidfdSendSignal(pidfd, unix.SIGKILL, nil, 0); err != nil {
+		t.Fatalf("PidfdSendSignal: %v", err)
+	}
+	if err := unix.ProcessMrelease(pidfd, 0); err != nil {
+		t.Errorf("ProcessMrelease on a killed process: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrAlreadyRunning is returned by PidFile.Acquire if the pid file is locked
+// by another process, or by another PidFile in the same process.
+var ErrAlreadyRunning = errors.New("unix: pid file is locked by another process")
+
+// A PidFile is a file holding the process ID of a running daemon, locked to
+// guarantee that only one instance runs at a time. The zero value is ready
+// to use.
+type PidFile struct {
+	fd   int
+	path string
+	held bool
+}
+
+// Acquire creates the file at path if needed, takes an exclusive open file
+// description lock (F_OFD_SETLK) on it and replaces its contents with the
+// process ID of the caller. If the file is already locked, Acquire returns
+// ErrAlreadyRunning. The lock is held until Release is called or the
+// process exits.
+func (p *PidFile) Acquire(path string) error {
+	if p.held {
+		return EBUSY
+	}
+	for {
+		fd, err := Open(path, O_RDWR|O_CREAT|O_CLOEXEC, 0644)
+		if err != nil {
+			return err
+		}
+		lk := Flock_t{Type: F_WRLCK, Whence: SEEK_SET}
+		if err := FcntlFlock(uintptr(fd), F_OFD_SETLK, &lk); err != nil {
+			Close(fd)
+			if err == EAGAIN || err == EACCES {
+				return ErrAlreadyRunning
+			}
+			return err
+		}
+		// The previous holder may have removed the file between our
+		// open and lock. Retry unless we locked the file at path.
+		var fst, pst Stat_t
+		if err := Fstat(fd, &fst); err != nil {
+			Close(fd)
+			return err
+		}
+		if err := Stat(path, &pst); err != nil || fst.Dev != pst.Dev || fst.Ino != pst.Ino {
+			Close(fd)
+			if err != nil && err != ENOENT {
+				return err
+			}
+			continue
+		}
+
+		pid := []byte(strconv.Itoa(Getpid()) + "\n")
+		if err := Ftruncate(fd, 0); err != nil {
+			Close(fd)
+			return err
+		}
+		if _, err := Pwrite(fd, pid, 0); err != nil {
+			Close(fd)
+			return err
+		}
+		p.fd, p.path, p.held = fd, path, true
+		return nil
+	}
+}
+
+// Release removes the pid file and releases the lock taken by Acquire.
+func (p *PidFile) Release() error {
+	if !p.held {
+		return EINVAL
+	}
+	// Unlink while still holding the lock, so that a concurrent Acquire
+	// never locks a file that is about to be removed.
+	err := Unlink(p.path)
+	if cerr := Close(p.fd); err == nil {
+		err = cerr
+	}
+	p.fd, p.path, p.held = 0, "", false
+	return err
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestPidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "daemon.pid")
+
+	var p1 unix.PidFile
+	if err := p1.Acquire(path); err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), strconv.Itoa(os.Getpid())+"\n"; got != want {
+		t.Errorf("pid file contains %q, want %q", got, want)
+	}
+
+	var p2 unix.PidFile
+	if err := p2.Acquire(path); err != unix.ErrAlreadyRunning {
+		t.Fatalf("second Acquire: got %v, want ErrAlreadyRunning", err)
+	}
+
+	if err := p1.Release(); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("pid file still exists after Release: %v", err)
+	}
+	if err := p1.Release(); err != unix.EINVAL {
+		t.Errorf("second Release: got %v, want EINVAL", err)
+	}
+
+	if err := p2.Acquire(path); err != nil {
+		t.Fatalf("Acquire after Release: %v", err)
+	}
+	if err := p2.Release(); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+}
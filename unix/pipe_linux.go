@@ -0,0 +1,48 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// pipeMaxSize returns the maximum pipe capacity an unprivileged process may
+// request, as configured in /proc/sys/fs/pipe-max-size.
+func pipeMaxSize() (int, error) {
+	b, err := os.ReadFile("/proc/sys/fs/pipe-max-size")
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(b)))
+}
+
+// BigPipe creates a pipe with the O_CLOEXEC flag set and grows its capacity
+// to at least size bytes using F_SETPIPE_SZ. It returns the read and write
+// ends of the pipe along with the capacity actually achieved, which the
+// kernel rounds up to a power-of-two number of pages.
+//
+// If size exceeds /proc/sys/fs/pipe-max-size and the caller lacks
+// CAP_SYS_RESOURCE, the capacity is set to the maximum allowed instead.
+func BigPipe(size int) (r, w int, actual int, err error) {
+	var p [2]int
+	if err = Pipe2(p[:], O_CLOEXEC); err != nil {
+		return -1, -1, 0, err
+	}
+	actual, err = FcntlInt(uintptr(p[0]), F_SETPIPE_SZ, size)
+	if err == EPERM {
+		var max int
+		if max, err = pipeMaxSize(); err == nil {
+			actual, err = FcntlInt(uintptr(p[0]), F_SETPIPE_SZ, max)
+		}
+	}
+	if err != nil {
+		Close(p[0])
+		Close(p[1])
+		return -1, -1, 0, err
+	}
+	return p[0], p[1], actual, nil
+}
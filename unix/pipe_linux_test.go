@@ -0,0 +1,48 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestBigPipe(t *testing.T) {
+	const size = 1 << 20
+	r, w, actual, err := unix.BigPipe(size)
+	if err != nil {
+		t.Fatalf("BigPipe: %v", err)
+	}
+	defer unix.Close(r)
+	defer unix.Close(w)
+
+	got, err := unix.FcntlInt(uintptr(w), unix.F_GETPIPE_SZ, 0)
+	if err != nil {
+		t.Fatalf("F_GETPIPE_SZ: %v", err)
+	}
+	if got != actual {
+		t.Errorf("F_GETPIPE_SZ = %d, BigPipe reported %d", got, actual)
+	}
+
+	pagesize := unix.Getpagesize()
+	want := (size + pagesize - 1) / pagesize * pagesize
+	if actual >= want {
+		return
+	}
+	b, err := os.ReadFile("/proc/sys/fs/pipe-max-size")
+	if err != nil {
+		t.Fatalf("BigPipe: got capacity %d, want >= %d", actual, want)
+	}
+	max, _ := strconv.Atoi(strings.TrimSpace(string(b)))
+	if actual < max {
+		t.Errorf("BigPipe: got capacity %d, want >= %d or pipe-max-size %d", actual, want, max)
+	}
+}
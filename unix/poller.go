@@ -0,0 +1,85 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+
+package unix
+
+import "time"
+
+// Poller waits for readiness on a set of file descriptors with Poll. It
+// keeps the PollFd slice passed to the kernel between calls, so that
+// repeated polling of the same descriptors does not allocate.
+//
+// The zero value is an empty Poller ready to use. A Poller must not be used
+// concurrently from multiple goroutines.
+type Poller struct {
+	fds   []PollFd
+	ready []PollFd
+}
+
+// Add registers fd to be polled for events, a combination of the POLL*
+// constants. If fd is already registered, its events are replaced.
+func (p *Poller) Add(fd int, events int16) {
+	for i := range p.fds {
+		if p.fds[i].Fd == int32(fd) {
+			p.fds[i].Events = events
+			return
+		}
+	}
+	p.fds = append(p.fds, PollFd{Fd: int32(fd), Events: events})
+}
+
+// Remove unregisters fd. It does nothing if fd is not registered.
+func (p *Poller) Remove(fd int) {
+	for i := range p.fds {
+		if p.fds[i].Fd == int32(fd) {
+			last := len(p.fds) - 1
+			p.fds[i] = p.fds[last]
+			p.fds = p.fds[:last]
+			return
+		}
+	}
+}
+
+// Poll waits until at least one registered file descriptor is ready or the
+// timeout expires, and returns the descriptors with a non-zero Revents. A
+// negative timeout waits indefinitely and a zero timeout returns
+// immediately. Poll is restarted if it is interrupted by a signal, with the
+// remaining timeout. The returned slice is reused by the next call to Poll.
+func (p *Poller) Poll(timeout time.Duration) (ready []PollFd, err error) {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	for {
+		ms := -1
+		if timeout >= 0 {
+			// Round up so that a short positive timeout does not busy loop.
+			ms = int((timeout + time.Millisecond - 1) / time.Millisecond)
+		}
+		for i := range p.fds {
+			p.fds[i].Revents = 0
+		}
+		_, err = Poll(p.fds, ms)
+		if err != EINTR {
+			break
+		}
+		if timeout > 0 {
+			if timeout = time.Until(deadline); timeout < 0 {
+				timeout = 0
+			}
+		}
+	}
+	p.ready = p.ready[:0]
+	if err != nil {
+		return nil, err
+	}
+	for _, fd := range p.fds {
+		if fd.Revents != 0 {
+			p.ready = append(p.ready, fd)
+		}
+	}
+	return p.ready, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+
+package unix_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestPoller(t *testing.T) {
+	var a, b [2]int
+	for _, p := range []*[2]int{&a, &b} {
+		if err := unix.Pipe(p[:]); err != nil {
+			t.Fatalf("Pipe: %v", err)
+		}
+		defer unix.Close(p[0])
+		defer unix.Close(p[1])
+	}
+
+	var p unix.Poller
+	p.Add(a[0], unix.POLLIN)
+	p.Add(b[0], unix.POLLIN)
+
+	ready, err := p.Poll(0)
+	if err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+	if len(ready) != 0 {
+		t.Fatalf("Poll with no data = %v, want no ready descriptors", ready)
+	}
+
+	if _, err := unix.Write(b[1], []byte("x")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	ready, err = p.Poll(time.Second)
+	if err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+	if len(ready) != 1 || ready[0].Fd != int32(b[0]) || ready[0].Revents&unix.POLLIN == 0 {
+		t.Fatalf("Poll = %v, want only fd %d readable", ready, b[0])
+	}
+
+	p.Remove(b[0])
+	ready, err = p.Poll(10 * time.Millisecond)
+	if err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+	if len(ready) != 0 {
+		t.Errorf("Poll after Remove = %v, want no ready descriptors", ready)
+	}
+}
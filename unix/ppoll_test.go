@@ -0,0 +1,49 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build dragonfly || freebsd || linux || netbsd || openbsd
+
+package unix_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestPpollPipe(t *testing.T) {
+	var p [2]int
+	if err := unix.Pipe(p[:]); err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+
+	ppoll := func() (int, unix.PollFd) {
+		t.Helper()
+		fds := []unix.PollFd{{Fd: int32(p[0]), Events: unix.POLLIN}}
+		ts := unix.NsecToTimespec(int64(10 * time.Millisecond))
+		for {
+			n, err := unix.Ppoll(fds, &ts, nil)
+			if err == unix.EINTR {
+				continue
+			}
+			if err != nil {
+				t.Fatalf("Ppoll: %v", err)
+			}
+			return n, fds[0]
+		}
+	}
+
+	if n, _ := ppoll(); n != 0 {
+		t.Fatalf("Ppoll on an empty pipe returned %d events, want timeout", n)
+	}
+	if _, err := unix.Write(p[1], []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	if n, fd := ppoll(); n != 1 || fd.Revents&unix.POLLIN == 0 {
+		t.Errorf("Ppoll after write = %d (revents %#x), want POLLIN", n, fd.Revents)
+	}
+}
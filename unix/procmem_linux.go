@@ -0,0 +1,157 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Access to the memory of other processes.
+
+package unix
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// iovMax is the maximum number of iovec elements accepted by a single
+// system call (UIO_MAXIOV).
+const iovMax = 1024
+
+// ReadProcessMemory reads len(out) bytes starting at addr in the address
+// space of the process pid into out, using process_vm_readv(2). The caller
+// needs permission to ptrace the process.
+//
+// The remote range is split into page-aligned pieces and read with at most
+// iovMax (UIO_MAXIOV) pieces per system call, so that a read can stop
+// precisely at the first page that is not mapped or not readable. In that
+// case ReadProcessMemory returns the number of bytes read up to that page
+// boundary along with EFAULT, or the error from the system call if nothing
+// could be read.
+func ReadProcessMemory(pid int, addr uintptr, out []byte) (int, error) {
+	pageSize := uintptr(Getpagesize())
+	remote := make([]RemoteIovec, 0, iovMax)
+	total := 0
+	for total < len(out) {
+		// Describe up to iovMax pages of the remaining range.
+		remote = remote[:0]
+		start := addr + uintptr(total)
+		p, chunk := start, 0
+		for len(remote) < iovMax && total+chunk < len(out) {
+			n := int(pageSize - p%pageSize)
+			if rest := len(out) - total - chunk; n > rest {
+				n = rest
+			}
+			remote = append(remote, RemoteIovec{Base: p, Len: n})
+			p += uintptr(n)
+			chunk += n
+		}
+		local := []Iovec{{Base: &out[total]}}
+		local[0].SetLen(chunk)
+
+		n, err := ProcessVMReadv(pid, local, remote, 0)
+		if err != nil {
+			if total > 0 {
+				err = EFAULT
+			}
+			return total, err
+		}
+		total += n
+		if n < chunk {
+			return total, EFAULT
+		}
+	}
+	return total, nil
+}
+
+// MemRegion describes a mapped region of a process's address space, as
+// listed in /proc/<pid>/maps.
+type MemRegion struct {
+	Start  uintptr
+	End    uintptr
+	Perms  string // e.g. "r-xp"; p is private, s shared
+	Offset uint64
+	Dev    uint64 // device of the mapped file, see Major and Minor
+	Inode  uint64
+	// Path is the mapped file, a special name such as "[heap]",
+	// "[stack]" or "[vdso]", or empty for anonymous mappings. Files
+	// that have been removed carry a " (deleted)" suffix.
+	Path string
+}
+
+// ProcMaps returns the memory regions mapped by the process pid, in
+// ascending address order. A pid of 0 refers to the calling process.
+func ProcMaps(pid int) ([]MemRegion, error) {
+	name := "/proc/self/maps"
+	if pid != 0 {
+		name = "/proc/" + strconv.Itoa(pid) + "/maps"
+	}
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	var regions []MemRegion
+	for _, line := range strings.Split(string(b), "\n") {
+		if line == "" {
+			continue
+		}
+		r, err := parseMemRegion(line)
+		if err != nil {
+			return nil, err
+		}
+		regions = append(regions, r)
+	}
+	return regions, nil
+}
+
+// parseMemRegion parses a line of /proc/<pid>/maps such as
+//
+//	7f0206bda000-7f0206bdc000 r-xp 00000000 00:00 0    [vdso]
+func parseMemRegion(line string) (MemRegion, error) {
+	var r MemRegion
+	var fields [5]string
+	rest := line
+	for i := range fields {
+		rest = strings.TrimLeft(rest, " ")
+		var ok bool
+		fields[i], rest, ok = strings.Cut(rest, " ")
+		if !ok && i < len(fields)-1 {
+			return r, EINVAL
+		}
+	}
+	// The path is padded to a fixed column but may itself contain spaces.
+	r.Path = strings.TrimLeft(rest, " ")
+
+	start, end, ok := strings.Cut(fields[0], "-")
+	if !ok {
+		return r, EINVAL
+	}
+	s, err := strconv.ParseUint(start, 16, 64)
+	if err != nil {
+		return r, err
+	}
+	e, err := strconv.ParseUint(end, 16, 64)
+	if err != nil {
+		return r, err
+	}
+	r.Start, r.End = uintptr(s), uintptr(e)
+	r.Perms = fields[1]
+	if r.Offset, err = strconv.ParseUint(fields[2], 16, 64); err != nil {
+		return r, err
+	}
+	maj, min, ok := strings.Cut(fields[3], ":")
+	if !ok {
+		return r, EINVAL
+	}
+	ma, err := strconv.ParseUint(maj, 16, 32)
+	if err != nil {
+		return r, err
+	}
+	mi, err := strconv.ParseUint(min, 16, 32)
+	if err != nil {
+		return r, err
+	}
+	r.Dev = Mkdev(uint32(ma), uint32(mi))
+	if r.Inode, err = strconv.ParseUint(fields[4], 10, 64); err != nil {
+		return r, err
+	}
+	return r, nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"testing"
+	"unsafe"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// procMemPattern returns the contents of the buffer shared by
+// TestReadProcessMemory and its child.
+func procMemPattern() []byte {
+	b := make([]byte, 5<<20+123)
+	for i := range b {
+		b[i] = byte(i*7 + i>>12)
+	}
+	return b
+}
+
+func TestReadProcessMemory(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") == "1" {
+		buf := procMemPattern()
+		fmt.Printf("%d %d\n", uintptr(unsafe.Pointer(&buf[0])), len(buf))
+		// Keep buf alive until the parent closes our stdin.
+		io.Copy(io.Discard, os.Stdin)
+		fmt.Fprint(io.Discard, buf[0])
+		os.Exit(0)
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(exe, "-test.run=^TestReadProcessMemory$")
+	cmd.Env = append(os.Environ(), "GO_WANT_HELPER_PROCESS=1")
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Wait()
+	defer stdin.Close()
+
+	var addr uintptr
+	var size int
+	if _, err := fmt.Fscan(bufio.NewReader(stdout), &addr, &size); err != nil {
+		t.Fatalf("reading child output: %v", err)
+	}
+
+	want := procMemPattern()
+	got := make([]byte, size)
+	n, err := unix.ReadProcessMemory(cmd.Process.Pid, addr, got)
+	if err == unix.EPERM || err == unix.ENOSYS {
+		t.Skipf("ReadProcessMemory: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("ReadProcessMemory: %v", err)
+	}
+	if n != len(want) || !bytes.Equal(got, want) {
+		t.Errorf("ReadProcessMemory read %d bytes not matching the child's buffer", n)
+	}
+
+	// An unaligned read in the middle of the buffer.
+	got = got[:3*os.Getpagesize()]
+	if _, err := unix.ReadProcessMemory(cmd.Process.Pid, addr+1001, got); err != nil {
+		t.Fatalf("ReadProcessMemory: %v", err)
+	}
+	if !bytes.Equal(got, want[1001:1001+len(got)]) {
+		t.Error("unaligned ReadProcessMemory returned wrong contents")
+	}
+
+	// Reading unmapped memory fails.
+	if n, err := unix.ReadProcessMemory(cmd.Process.Pid, 0, got); err == nil {
+		t.Errorf("ReadProcessMemory of address 0 returned %d bytes without error", n)
+	}
+
+	// A read running into an inaccessible page stops at its boundary.
+	ps := os.Getpagesize()
+	mem, err := unix.Mmap(-1, 0, 2*ps, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANONYMOUS)
+	if err != nil {
+		t.Fatalf("Mmap: %v", err)
+	}
+	defer unix.Munmap(mem)
+	copy(mem, want)
+	if err := unix.Mprotect(mem[ps:], unix.PROT_NONE); err != nil {
+		t.Fatalf("Mprotect: %v", err)
+	}
+	got = make([]byte, ps+100)
+	n, err = unix.ReadProcessMemory(os.Getpid(), uintptr(unsafe.Pointer(&mem[0])), got)
+	if n != ps || err != unix.EFAULT {
+		t.Errorf("ReadProcessMemory across an inaccessible page = %d, %v, want %d, EFAULT", n, err, ps)
+	}
+	if !bytes.Equal(got[:ps], want[:ps]) {
+		t.Error("partial ReadProcessMemory returned wrong contents")
+	}
+}
+
+func TestProcMaps(t *testing.T) {
+	regions, err := unix.ProcMaps(0)
+	if err != nil {
+		t.Fatalf("ProcMaps: %v", err)
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var st unix.Stat_t
+	if err := unix.Stat(exe, &st); err != nil {
+		t.Fatal(err)
+	}
+
+	var text, anon, stack bool
+	for i, r := range regions {
+		if r.End <= r.Start || len(r.Perms) != 4 {
+			t.Errorf("malformed region %+v", r)
+		}
+		if i > 0 && r.Start < regions[i-1].End {
+			t.Errorf("region %+v overlaps or precedes %+v", r, regions[i-1])
+		}
+		switch {
+		case r.Path == exe && r.Perms[2] == 'x':
+			text = true
+			if r.Inode != st.Ino || r.Dev != uint64(st.Dev) {
+				t.Errorf("executable region %+v does not match the inode %d of %s", r, st.Ino, exe)
+			}
+		case r.Path == "":
+			anon = anon || r.Inode == 0
+		case r.Path == "[stack]":
+			stack = true
+		}
+	}
+	if !text {
+		t.Errorf("no executable region for %s", exe)
+	}
+	if !anon {
+		t.Error("no anonymous region")
+	}
+	if !stack {
+		t.Error("no [stack] region")
+	}
+
+	self, err := unix.ProcMaps(os.Getpid())
+	if err != nil {
+		t.Fatalf("ProcMaps(%d): %v", os.Getpid(), err)
+	}
+	if len(self) == 0 {
+		t.Errorf("ProcMaps(%d) returned no regions", os.Getpid())
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build aix || dragonfly || freebsd || linux || netbsd
+
+package unix_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestPselectPipe(t *testing.T) {
+	var p [2]int
+	if err := unix.Pipe(p[:]); err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+
+	pselect := func() (int, *unix.FdSet) {
+		t.Helper()
+		for {
+			r := new(unix.FdSet)
+			unix.FDZero(r)
+			unix.FDSet(p[0], r)
+			ts := unix.NsecToTimespec(int64(10 * time.Millisecond))
+			n, err := unix.Pselect(p[0]+1, r, nil, nil, &ts, nil)
+			if err == unix.EINTR {
+				continue
+			}
+			if err != nil {
+				t.Fatalf("Pselect: %v", err)
+			}
+			return n, r
+		}
+	}
+
+	if n, r := pselect(); n != 0 || unix.FDIsSet(p[0], r) {
+		t.Fatalf("Pselect on an empty pipe = %d, want timeout", n)
+	}
+	if _, err := unix.Write(p[1], []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	if n, r := pselect(); n != 1 || !unix.FDIsSet(p[0], r) {
+		t.Errorf("Pselect after write = %d, want the read end readable", n)
+	}
+}
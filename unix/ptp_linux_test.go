@@ -0,0 +1,108 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// openPTP opens /dev/ptp0, skipping the test if there is none.
+func openPTP(t *testing.T) int {
+	fd, err := unix.Open("/dev/ptp0", unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Skipf("no PTP clock: %v", err)
+	}
+	t.Cleanup(func() { unix.Close(fd) })
+	return fd
+}
+
+func TestPHCTime(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "phc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := unix.PHCTime(int(f.Fd())); err != unix.EINVAL {
+		t.Errorf("PHCTime of a regular file: got %v, want EINVAL", err)
+	}
+
+	fd := openPTP(t)
+	phc, err := unix.PHCTime(fd)
+	if err != nil {
+		t.Fatalf("PHCTime: %v", err)
+	}
+	// An unsynchronized clock may start anywhere, but it should not
+	// predate the epoch or be centuries ahead.
+	if phc.Before(time.Unix(0, 0)) || phc.After(time.Now().AddDate(100, 0, 0)) {
+		t.Errorf("implausible PHC time %v", phc)
+	}
+}
+
+func TestIoctlPtpClockGetcaps(t *testing.T) {
+	fd := openPTP(t)
+	caps, err := unix.IoctlPtpClockGetcaps(fd)
+	if err != nil {
+		t.Fatalf("IoctlPtpClockGetcaps: %v", err)
+	}
+	t.Logf("%+v", *caps)
+	if caps.N_alarm < 0 || caps.N_ext_ts < 0 || caps.N_per_out < 0 || caps.N_pins < 0 {
+		t.Errorf("negative counts in PTP clock caps %+v", *caps)
+	}
+}
+
+func TestIoctlPtpSysOffset(t *testing.T) {
+	fd := openPTP(t)
+	const samples = 3
+	off, err := unix.IoctlPtpSysOffset(fd, samples)
+	if err != nil {
+		t.Fatalf("IoctlPtpSysOffset: %v", err)
+	}
+	// The readings alternate system, PTP, system, ... and the system
+	// clock readings are increasing.
+	for i := 2; i <= 2*samples; i += 2 {
+		prev, cur := off.Ts[i-2], off.Ts[i]
+		if cur.Sec < prev.Sec || cur.Sec == prev.Sec && cur.Nsec < prev.Nsec {
+			t.Errorf("system clock readings %d and %d out of order: %+v, %+v", i-2, i, prev, cur)
+		}
+	}
+}
+
+func TestBindSocketToPHC(t *testing.T) {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	if err := unix.BindSocketToPHC(fd, 1<<30); err != unix.ENODEV {
+		t.Errorf("BindSocketToPHC of a missing interface: got %v, want ENODEV", err)
+	}
+
+	lo, err := net.InterfaceByName("lo")
+	if err != nil {
+		t.Skipf("no loopback interface: %v", err)
+	}
+	// The loopback device has no hardware clock, so the configuration is
+	// rejected before the socket is touched.
+	switch err := unix.BindSocketToPHC(fd, lo.Index); err {
+	case unix.EOPNOTSUPP:
+		if v, err := unix.GetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_TIMESTAMPING); err != nil || v != 0 {
+			t.Errorf("SO_TIMESTAMPING after failed BindSocketToPHC = %#x, %v; want 0", v, err)
+		}
+	case unix.EPERM:
+		t.Skip("configuring hardware timestamps requires CAP_NET_ADMIN")
+	case nil:
+		t.Skip("loopback device unexpectedly supports hardware timestamps")
+	default:
+		t.Fatalf("BindSocketToPHC(lo): got %v, want EOPNOTSUPP", err)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// urandomFd is a file descriptor for /dev/urandom, opened on first use and
+// kept open for the lifetime of the process.
+var urandomFd = sync.OnceValues(func() (int, error) {
+	return Open("/dev/urandom", O_RDONLY|O_CLOEXEC, 0)
+})
+
+// UrandomRead fills b with random bytes read from /dev/urandom. The device
+// is opened on the first call and the file descriptor is reused afterwards.
+// Unlike getrandom(2), reading /dev/urandom never blocks, even before the
+// kernel's entropy pool has been initialized.
+func UrandomRead(b []byte) error {
+	fd, err := urandomFd()
+	if err != nil {
+		return err
+	}
+	for len(b) > 0 {
+		n, err := Read(fd, b)
+		if err == EINTR {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return EIO
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// RandRead fills b with random bytes from getrandom(2), looping on short
+// reads. If the system call is unavailable, for example because it is
+// blocked by a seccomp filter returning ENOSYS, it falls back to
+// UrandomRead.
+func RandRead(b []byte) error {
+	for len(b) > 0 {
+		n, err := Getrandom(b, 0)
+		switch err {
+		case nil:
+			b = b[n:]
+		case EINTR:
+		case ENOSYS:
+			return UrandomRead(b)
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// randomPollInterval is how often WaitForRandom checks whether the kernel's
+// random number generator has been seeded.
+const randomPollInterval = 100 * time.Millisecond
+
+// WaitForRandom blocks until the kernel's cryptographic random number
+// generator has been initialized, or until ctx is done, in which case it
+// returns ctx.Err(). On a seeded system it returns immediately. It is
+// meant for daemons started early during boot that must not consume
+// predictable random numbers.
+//
+// WaitForRandom checks getrandom(2) with GRND_NONBLOCK. If the system call
+// is unavailable it waits for /dev/random to become readable instead.
+func WaitForRandom(ctx context.Context) error {
+	var b [1]byte
+	for {
+		_, err := Getrandom(b[:], GRND_NONBLOCK)
+		switch err {
+		case nil:
+			return nil
+		case EAGAIN, EINTR:
+		case ENOSYS:
+			return waitDevRandom(ctx)
+		default:
+			return err
+		}
+		if err := sleepContext(ctx, randomPollInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// waitDevRandom waits for /dev/random to become readable, which it does
+// once the random number generator has been initialized.
+func waitDevRandom(ctx context.Context) error {
+	fd, err := Open("/dev/random", O_RDONLY|O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer Close(fd)
+	for {
+		fds := []PollFd{{Fd: int32(fd), Events: POLLIN}}
+		n, err := Poll(fds, int(randomPollInterval/time.Millisecond))
+		if err != nil && err != EINTR {
+			return err
+		}
+		if n > 0 {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// sleepContext waits for d to elapse or for ctx to be done, whichever
+// happens first, and returns ctx.Err() in the latter case.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
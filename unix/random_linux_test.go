@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestRandRead(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		read func([]byte) error
+	}{
+		{"UrandomRead", unix.UrandomRead},
+		{"RandRead", unix.RandRead},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// Larger than a single getrandom call returns at once.
+			a := make([]byte, 64<<20)
+			if err := tt.read(a); err != nil {
+				t.Fatalf("%s: %v", tt.name, err)
+			}
+			if tail := a[len(a)-64:]; bytes.Equal(tail, make([]byte, 64)) {
+				t.Errorf("%s left the end of the buffer zero", tt.name)
+			}
+			b := make([]byte, 64)
+			if err := tt.read(b); err != nil {
+				t.Fatalf("%s: %v", tt.name, err)
+			}
+			if bytes.Equal(b, make([]byte, 64)) || bytes.Equal(a[:64], b) {
+				t.Errorf("%s returned non-random data %x", tt.name, b)
+			}
+			if err := tt.read(nil); err != nil {
+				t.Errorf("%s(nil): %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestWaitForRandom(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	start := time.Now()
+	if err := unix.WaitForRandom(ctx); err != nil {
+		t.Fatalf("WaitForRandom: %v", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("WaitForRandom took %v on a running system", d)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	// The generator is already seeded, so the canceled context is never
+	// consulted.
+	if err := unix.WaitForRandom(ctx); err != nil {
+		t.Errorf("WaitForRandom with a canceled context: %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+// RemoveAllFd removes name, relative to the directory dirfd, and anything it
+// contains. Unlike os.RemoveAll it never stats entries: each entry is first
+// unlinked and only treated as a directory if that fails with EISDIR.
+// Directories are opened relative to their parent with O_NOFOLLOW and walked
+// with getdents(2), so paths are never re-resolved from the top and a symlink
+// swapped in for a directory is not followed.
+//
+// If name does not exist, RemoveAllFd returns nil.
+func RemoveAllFd(dirfd int, name string) error {
+	err := Unlinkat(dirfd, name, 0)
+	switch err {
+	case nil, ENOENT:
+		return nil
+	case EISDIR:
+		// name is a directory. Linux reports EISDIR rather than the EPERM
+		// of POSIX, which here only means the entry cannot be removed.
+	default:
+		return err
+	}
+
+	fd, err := Openat(dirfd, name, O_RDONLY|O_DIRECTORY|O_NOFOLLOW|O_CLOEXEC, 0)
+	if err != nil {
+		if err == ENOENT {
+			return nil
+		}
+		return err
+	}
+	err = removeDirContents(fd)
+	Close(fd)
+	if err != nil {
+		return err
+	}
+	if err := Unlinkat(dirfd, name, AT_REMOVEDIR); err != nil && err != ENOENT {
+		return err
+	}
+	return nil
+}
+
+// removeDirContents removes every entry of the directory fd.
+func removeDirContents(fd int) error {
+	// Read all names before removing anything, as the effect of removing
+	// entries on an ongoing getdents(2) iteration is unspecified.
+	var names []string
+	buf := make([]byte, 8192)
+	for {
+		n, err := Getdents(fd, buf)
+		if err != nil {
+			if err == EINTR {
+				continue
+			}
+			return err
+		}
+		if n <= 0 {
+			break
+		}
+		_, _, names = ParseDirent(buf[:n], -1, names)
+	}
+	for _, name := range names {
+		if err := RemoveAllFd(fd, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestRemoveAllFd(t *testing.T) {
+	dir := t.TempDir()
+	root := filepath.Join(dir, "tree")
+	outside := t.TempDir()
+	sentinel := filepath.Join(outside, "sentinel")
+	if err := os.WriteFile(sentinel, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// A deep chain of directories with a few files at each level.
+	p := root
+	for depth := 0; depth < 64; depth++ {
+		if err := os.MkdirAll(p, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 3; i++ {
+			name := filepath.Join(p, "file"+strconv.Itoa(i))
+			if err := os.WriteFile(name, []byte("x"), 0444); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := os.Symlink(outside, filepath.Join(p, "link")); err != nil {
+			t.Fatal(err)
+		}
+		p = filepath.Join(p, "d"+strconv.Itoa(depth))
+	}
+	// Enough entries in one directory to need several getdents calls.
+	wide := filepath.Join(root, "wide")
+	if err := os.Mkdir(wide, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		if err := os.WriteFile(filepath.Join(wide, "entry-with-a-long-name-"+strconv.Itoa(i)), nil, 0400); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dirfd, err := unix.Open(dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(dirfd)
+
+	if err := unix.RemoveAllFd(dirfd, "tree"); err != nil {
+		t.Fatalf("RemoveAllFd: %v", err)
+	}
+	if _, err := os.Lstat(root); !os.IsNotExist(err) {
+		t.Errorf("Lstat after RemoveAllFd: got %v, want not exist", err)
+	}
+	// The symlinks must have been removed, not followed.
+	if _, err := os.Stat(sentinel); err != nil {
+		t.Errorf("file outside the tree was removed: %v", err)
+	}
+
+	// Removing something that does not exist is not an error.
+	if err := unix.RemoveAllFd(dirfd, "tree"); err != nil {
+		t.Errorf("RemoveAllFd of missing entry: %v", err)
+	}
+
+	// A plain file is removed as well.
+	if err := os.WriteFile(filepath.Join(dir, "file"), nil, 0444); err != nil {
+		t.Fatal(err)
+	}
+	if err := unix.RemoveAllFd(dirfd, "file"); err != nil {
+		t.Errorf("RemoveAllFd of file: %v", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("directory still contains %d entries", len(entries))
+	}
+}
+
+func TestRemoveAllFdEPERM(t *testing.T) {
+	// An immutable file cannot be unlinked, which fails with EPERM. It must
+	// not be mistaken for a directory.
+	const fsImmutableFL = 0x10 // FS_IMMUTABLE_FL from <linux/fs.h>
+	dir := t.TempDir()
+	name := filepath.Join(dir, "file")
+	if err := os.WriteFile(name, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	fd, err := unix.Open(name, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+	if err := unix.IoctlSetPointerInt(fd, unix.FS_IOC_SETFLAGS, fsImmutableFL); err != nil {
+		t.Skipf("cannot make a file immutable: %v", err)
+	}
+	defer unix.IoctlSetPointerInt(fd, unix.FS_IOC_SETFLAGS, 0)
+
+	dirfd, err := unix.Open(dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(dirfd)
+	if err := unix.RemoveAllFd(dirfd, "file"); err != unix.EPERM {
+		t.Errorf("RemoveAllFd of an immutable file: got %v, want EPERM", err)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import "path/filepath"
+
+// AtomicReplace atomically replaces the contents of the file at path.
+//
+// It creates a temporary file in the same directory as path and calls write
+// with its file descriptor to fill it. The temporary file is then flushed to
+// disk with fsync and swapped into place with renameat2(2) and
+// RENAME_EXCHANGE, after which the old file is removed. If path does not
+// exist yet, or the file system does not support RENAME_EXCHANGE, a plain
+// rename is used instead. Either way, concurrent readers of path observe
+// either the complete old contents or the complete new contents, never a
+// mixture.
+//
+// If path already exists, the new file is given the same permission bits.
+// If write returns an error, the temporary file is removed and path is left
+// untouched.
+func AtomicReplace(path string, write func(fd int) error) error {
+	dir, base := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+	dirfd, err := Open(dir, O_RDONLY|O_DIRECTORY|O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer Close(dirfd)
+
+	fd, tmp, err := Mkostempsat(dirfd, "."+base+".XXXXXX", 0, O_CLOEXEC)
+	if err != nil {
+		return err
+	}
+	if err := fillTemp(fd, dirfd, base, write); err != nil {
+		Close(fd)
+		Unlinkat(dirfd, tmp, 0)
+		return err
+	}
+	if err := Close(fd); err != nil {
+		Unlinkat(dirfd, tmp, 0)
+		return err
+	}
+
+	err = Renameat2(dirfd, tmp, dirfd, base, RENAME_EXCHANGE)
+	switch err {
+	case nil:
+		// tmp now refers to the old file.
+		err = Unlinkat(dirfd, tmp, 0)
+	case ENOENT, EINVAL, ENOSYS:
+		err = Renameat(dirfd, tmp, dirfd, base)
+	}
+	if err != nil {
+		Unlinkat(dirfd, tmp, 0)
+		return err
+	}
+	return Fsync(dirfd)
+}
+
+// fillTemp copies the permission bits of name in dirfd, if it exists, to
+// fd, calls write to fill fd and syncs it to disk.
+func fillTemp(fd, dirfd int, name string, write func(fd int) error) error {
+	var st Stat_t
+	if err := Fstatat(dirfd, name, &st, 0); err == nil {
+		if err := Fchmod(fd, st.Mode&07777); err != nil {
+			return err
+		}
+	}
+	if err := write(fd); err != nil {
+		return err
+	}
+	return Fsync(fd)
+}
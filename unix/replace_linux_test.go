@@ -0,0 +1,115 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestAtomicReplace(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config")
+	contents := func(i int) []byte {
+		return bytes.Repeat([]byte{byte('a' + i%26)}, 64<<10)
+	}
+	if err := os.WriteFile(path, contents(0), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				b, err := os.ReadFile(path)
+				if err != nil {
+					t.Errorf("ReadFile: %v", err)
+					return
+				}
+				if len(b) != 64<<10 || !bytes.Equal(b, bytes.Repeat(b[:1], len(b))) {
+					t.Errorf("reader observed partial contents (%d bytes)", len(b))
+					return
+				}
+			}
+		}()
+	}
+
+	const replacements = 50
+	for i := 1; i <= replacements; i++ {
+		want := contents(i)
+		err := unix.AtomicReplace(path, func(fd int) error {
+			// Write in two halves to widen the window for a
+			// reader to observe a partial file.
+			if _, err := unix.Write(fd, want[:len(want)/2]); err != nil {
+				return err
+			}
+			_, err := unix.Write(fd, want[len(want)/2:])
+			return err
+		})
+		if err != nil {
+			t.Fatalf("AtomicReplace: %v", err)
+		}
+	}
+	close(done)
+	wg.Wait()
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, contents(replacements)) {
+		t.Errorf("final contents are not those of the last replacement")
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := fi.Mode().Perm(); perm != 0640 {
+		t.Errorf("permissions = %v, want %v", perm, os.FileMode(0640))
+	}
+
+	// A failing callback leaves the file untouched.
+	errWrite := errors.New("write failed")
+	if err := unix.AtomicReplace(path, func(int) error { return errWrite }); err != errWrite {
+		t.Errorf("AtomicReplace: got error %v, want %v", err, errWrite)
+	}
+
+	// Replacing a file that does not exist creates it.
+	newPath := filepath.Join(dir, "new")
+	if err := unix.AtomicReplace(newPath, func(fd int) error {
+		_, err := unix.Write(fd, []byte("new"))
+		return err
+	}); err != nil {
+		t.Fatalf("AtomicReplace of missing file: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		t.Errorf("directory contains %q, want only the replaced files", names)
+	}
+}
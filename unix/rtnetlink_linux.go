@@ -0,0 +1,297 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Network interface configuration with rtnetlink, see rtnetlink(7).
+
+package unix
+
+import (
+	"net"
+	"unsafe"
+)
+
+// LinkAttrs describes a network interface as reported by LinkList.
+type LinkAttrs struct {
+	Index        int
+	Name         string
+	Flags        uint32 // IFF_* flags
+	MTU          int
+	HardwareAddr net.HardwareAddr
+	MasterIndex  int    // index of the bridge or bond the link is a port of, or 0
+	Kind         string // driver kind such as "veth" or "bridge", empty for physical devices
+}
+
+// LinkList returns the network interfaces in the calling thread's network
+// namespace.
+func LinkList() ([]LinkAttrs, error) {
+	ifi := IfInfomsg{Family: AF_UNSPEC}
+	msgs, err := netlinkRequest(NETLINK_ROUTE, RTM_GETLINK, NLM_F_DUMP, ifInfomsgBytes(&ifi))
+	if err != nil {
+		return nil, err
+	}
+	links := make([]LinkAttrs, 0, len(msgs))
+	for _, b := range msgs {
+		if len(b) < SizeofIfInfomsg {
+			return nil, EINVAL
+		}
+		ifi := (*IfInfomsg)(unsafe.Pointer(&b[0]))
+		attrs, err := parseNetlinkAttrs(b[rtaAlign(SizeofIfInfomsg):])
+		if err != nil {
+			return nil, err
+		}
+		l := LinkAttrs{Index: int(ifi.Index), Flags: ifi.Flags}
+		for _, a := range attrs {
+			switch a.Type {
+			case IFLA_IFNAME:
+				l.Name = ByteSliceToString(a.Value)
+			case IFLA_MTU:
+				if len(a.Value) >= 4 {
+					l.MTU = int(*(*uint32)(unsafe.Pointer(&a.Value[0])))
+				}
+			case IFLA_ADDRESS:
+				l.HardwareAddr = net.HardwareAddr(a.Value)
+			case IFLA_MASTER:
+				if len(a.Value) >= 4 {
+					l.MasterIndex = int(*(*uint32)(unsafe.Pointer(&a.Value[0])))
+				}
+			case IFLA_LINKINFO:
+				info, err := parseNetlinkAttrs(a.Value)
+				if err != nil {
+					return nil, err
+				}
+				for _, ia := range info {
+					if ia.Type == IFLA_INFO_KIND {
+						l.Kind = ByteSliceToString(ia.Value)
+					}
+				}
+			}
+		}
+		links = append(links, l)
+	}
+	return links, nil
+}
+
+// LinkStats returns the 64-bit traffic counters of the network interface
+// with the given index, read from its IFLA_STATS64 attribute. Counters
+// unknown to the running kernel are left zero.
+func LinkStats(index int) (*LinkStats64, error) {
+	ifi := IfInfomsg{Family: AF_UNSPEC, Index: int32(index)}
+	msgs, err := netlinkRequest(NETLINK_ROUTE, RTM_GETLINK, 0, ifInfomsgBytes(&ifi))
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) != 1 || len(msgs[0]) < SizeofIfInfomsg {
+		return nil, EINVAL
+	}
+	attrs, err := parseNetlinkAttrs(msgs[0][rtaAlign(SizeofIfInfomsg):])
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range attrs {
+		if a.Type == IFLA_STATS64 {
+			var st LinkStats64
+			copy((*[unsafe.Sizeof(st)]byte)(unsafe.Pointer(&st))[:], a.Value)
+			return &st, nil
+		}
+	}
+	return nil, ENODATA
+}
+
+func ifInfomsgBytes(ifi *IfInfomsg) []byte {
+	return (*[SizeofIfInfomsg]byte)(unsafe.Pointer(ifi))[:]
+}
+
+// CreateVethPair creates a pair of connected virtual Ethernet devices named
+// name and peer. Packets sent on either device are received on the other.
+// It requires CAP_NET_ADMIN.
+func CreateVethPair(name, peer string) error {
+	peerName, err := ByteSliceFromString(peer)
+	if err != nil {
+		return err
+	}
+	data := append([]byte(nil), ifInfomsgBytes(&IfInfomsg{Family: AF_UNSPEC})...)
+	data = appendNetlinkAttr(data, IFLA_IFNAME, peerName)
+	return linkAdd(name, "veth", appendNetlinkAttr(nil, VETH_INFO_PEER, data))
+}
+
+// CreateDummyInterface creates a dummy network interface named name, which
+// drops every packet sent to it. Once up, addresses assigned to it are
+// reachable locally like those of the loopback interface. It requires
+// CAP_NET_ADMIN.
+func CreateDummyInterface(name string) error {
+	return linkAdd(name, "dummy", nil)
+}
+
+// CreateBridge creates a Linux bridge named name. Interfaces are attached
+// to it as ports with SetMaster. It requires CAP_NET_ADMIN.
+func CreateBridge(name string) error {
+	return linkAdd(name, "bridge", nil)
+}
+
+// linkAdd creates the network interface name with an RTM_NEWLINK request
+// for the link kind, passing data as its IFLA_INFO_DATA if not nil.
+func linkAdd(name, kind string, data []byte) error {
+	ifname, err := ByteSliceFromString(name)
+	if err != nil {
+		return err
+	}
+	info := appendNetlinkAttr(nil, IFLA_INFO_KIND, []byte(kind))
+	if data != nil {
+		info = appendNetlinkAttr(info, IFLA_INFO_DATA, data)
+	}
+	req := append([]byte(nil), ifInfomsgBytes(&IfInfomsg{Family: AF_UNSPEC})...)
+	req = appendNetlinkAttr(req, IFLA_IFNAME, ifname)
+	req = appendNetlinkAttr(req, IFLA_LINKINFO, info)
+	_, err = netlinkRequest(NETLINK_ROUTE, RTM_NEWLINK, NLM_F_CREATE|NLM_F_EXCL|NLM_F_ACK, req)
+	return err
+}
+
+// LinkSetNsFd moves the network interface with the given index to the
+// network namespace referred to by nsFd, a file descriptor for a
+// /proc/<pid>/ns/net file or a bind mount of it. It requires CAP_NET_ADMIN
+// in both namespaces.
+func LinkSetNsFd(index int, nsFd int) error {
+	ifi := IfInfomsg{Family: AF_UNSPEC, Index: int32(index)}
+	return linkSet(&ifi, appendNetlinkAttr(nil, IFLA_NET_NS_FD, uint32Bytes(uint32(nsFd))))
+}
+
+// LinkSetUp brings the network interface with the given index up. It
+// requires CAP_NET_ADMIN.
+func LinkSetUp(index int) error {
+	ifi := IfInfomsg{Family: AF_UNSPEC, Index: int32(index), Flags: IFF_UP, Change: IFF_UP}
+	return linkSet(&ifi, nil)
+}
+
+// LinkSetDown brings the network interface with the given index down. It
+// requires CAP_NET_ADMIN.
+func LinkSetDown(index int) error {
+	ifi := IfInfomsg{Family: AF_UNSPEC, Index: int32(index), Change: IFF_UP}
+	return linkSet(&ifi, nil)
+}
+
+// LinkSetMTU sets the maximum transmission unit of the network interface
+// with the given index. It requires CAP_NET_ADMIN.
+func LinkSetMTU(index, mtu int) error {
+	if mtu <= 0 {
+		return EINVAL
+	}
+	ifi := IfInfomsg{Family: AF_UNSPEC, Index: int32(index)}
+	return linkSet(&ifi, appendNetlinkAttr(nil, IFLA_MTU, uint32Bytes(uint32(mtu))))
+}
+
+// LinkSetHardwareAddr sets the hardware address of the network interface
+// with the given index. Many drivers require the interface to be down. It
+// requires CAP_NET_ADMIN.
+func LinkSetHardwareAddr(index int, addr net.HardwareAddr) error {
+	if len(addr) == 0 {
+		return EINVAL
+	}
+	ifi := IfInfomsg{Family: AF_UNSPEC, Index: int32(index)}
+	return linkSet(&ifi, appendNetlinkAttr(nil, IFLA_ADDRESS, addr))
+}
+
+// SetMaster attaches the network interface with the given index to the
+// bridge or bond with index masterIndex, or detaches it from its current
+// master if masterIndex is 0. It requires CAP_NET_ADMIN.
+func SetMaster(index, masterIndex int) error {
+	ifi := IfInfomsg{Family: AF_UNSPEC, Index: int32(index)}
+	return linkSet(&ifi, appendNetlinkAttr(nil, IFLA_MASTER, uint32Bytes(uint32(masterIndex))))
+}
+
+// linkSet changes the flags selected by ifi.Change of the network interface
+// ifi.Index and the given attributes with an RTM_SETLINK request.
+func linkSet(ifi *IfInfomsg, attrs []byte) error {
+	req := append([]byte(nil), ifInfomsgBytes(ifi)...)
+	req = append(req, attrs...)
+	_, err := netlinkRequest(NETLINK_ROUTE, RTM_SETLINK, NLM_F_ACK, req)
+	return err
+}
+
+// uint32Bytes returns v in native byte order, the encoding of integer
+// netlink attributes.
+func uint32Bytes(v uint32) []byte {
+	return (*[4]byte)(unsafe.Pointer(&v))[:]
+}
+
+// IfAddr is an IP address assigned to a network interface, as reported by
+// AddrList.
+type IfAddr struct {
+	Index     int
+	IP        net.IP
+	PrefixLen int
+}
+
+// AddrList returns the IPv4 and IPv6 addresses assigned to the network
+// interface with the given index, or to all interfaces if index is 0.
+func AddrList(index int) ([]IfAddr, error) {
+	ifa := IfAddrmsg{Family: AF_UNSPEC}
+	msgs, err := netlinkRequest(NETLINK_ROUTE, RTM_GETADDR, NLM_F_DUMP,
+		(*[SizeofIfAddrmsg]byte)(unsafe.Pointer(&ifa))[:])
+	if err != nil {
+		return nil, err
+	}
+	var addrs []IfAddr
+	for _, b := range msgs {
+		if len(b) < SizeofIfAddrmsg {
+			return nil, EINVAL
+		}
+		ifa := (*IfAddrmsg)(unsafe.Pointer(&b[0]))
+		if index != 0 && int(ifa.Index) != index {
+			continue
+		}
+		attrs, err := parseNetlinkAttrs(b[rtaAlign(SizeofIfAddrmsg):])
+		if err != nil {
+			return nil, err
+		}
+		a := IfAddr{Index: int(ifa.Index), PrefixLen: int(ifa.Prefixlen)}
+		for _, attr := range attrs {
+			// On point-to-point links IFA_ADDRESS is the address of the
+			// peer, so prefer IFA_LOCAL.
+			switch attr.Type {
+			case IFA_LOCAL:
+				a.IP = net.IP(attr.Value)
+			case IFA_ADDRESS:
+				if a.IP == nil {
+					a.IP = net.IP(attr.Value)
+				}
+			}
+		}
+		addrs = append(addrs, a)
+	}
+	return addrs, nil
+}
+
+// AddrAdd assigns the address ip with the given prefix length to the
+// network interface with the given index. It requires CAP_NET_ADMIN.
+func AddrAdd(index int, ip net.IP, prefixLen int) error {
+	return addrRequest(RTM_NEWADDR, NLM_F_CREATE|NLM_F_EXCL, index, ip, prefixLen)
+}
+
+// AddrDel removes the address ip with the given prefix length from the
+// network interface with the given index. It requires CAP_NET_ADMIN.
+func AddrDel(index int, ip net.IP, prefixLen int) error {
+	return addrRequest(RTM_DELADDR, 0, index, ip, prefixLen)
+}
+
+func addrRequest(typ, flags uint16, index int, ip net.IP, prefixLen int) error {
+	family := AF_INET6
+	if ip4 := ip.To4(); ip4 != nil {
+		family, ip = AF_INET, ip4
+	} else if len(ip) != net.IPv6len {
+		return EINVAL
+	}
+	if prefixLen < 0 || prefixLen > len(ip)*8 {
+		return EINVAL
+	}
+	ifa := IfAddrmsg{
+		Family:    uint8(family),
+		Prefixlen: uint8(prefixLen),
+		Index:     uint32(index),
+	}
+	req := append([]byte(nil), (*[SizeofIfAddrmsg]byte)(unsafe.Pointer(&ifa))[:]...)
+	req = appendNetlinkAttr(req, IFA_LOCAL, ip)
+	req = appendNetlinkAttr(req, IFA_ADDRESS, ip)
+	_, err := netlinkRequest(NETLINK_ROUTE, typ, flags|NLM_F_ACK, req)
+	return err
+}
@@ -0,0 +1,440 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// runNetnsHelper runs fn in a helper process that re-executes the current
+// test, so that network namespaces created by fn do not leak into other
+// tests through locked threads. An error from fn fails the test, unless its
+// message starts with "skip: ".
+func runNetnsHelper(t *testing.T, fn func() error) {
+	t.Helper()
+	if os.Getenv("GO_WANT_HELPER_PROCESS") == "netns" {
+		if err := fn(); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Println("ok")
+		os.Exit(0)
+	}
+	if os.Getuid() != 0 {
+		t.Skip("creating a network namespace requires root")
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(exe, "-test.run=^"+t.Name()+"$")
+	cmd.Env = append(os.Environ(), "GO_WANT_HELPER_PROCESS=netns")
+	out, err := cmd.Output()
+	line := strings.TrimSpace(string(out))
+	if err == nil && line == "ok" {
+		return
+	}
+	if strings.HasPrefix(line, "skip: ") {
+		t.Skip(line)
+	}
+	t.Fatalf("helper process: %s (%v)", line, err)
+}
+
+// enterNewNetns moves the helper process's test goroutine to a new network
+// namespace for the rest of its life.
+func enterNewNetns() error {
+	if _, err := unix.NewNetworkNamespace(); err != nil {
+		if err == unix.EPERM {
+			return fmt.Errorf("skip: NewNetworkNamespace: %v", err)
+		}
+		return fmt.Errorf("NewNetworkNamespace: %v", err)
+	}
+	return nil
+}
+
+// findLink returns the interface named name from LinkList.
+func findLink(name string) (*unix.LinkAttrs, error) {
+	links, err := unix.LinkList()
+	if err != nil {
+		return nil, fmt.Errorf("LinkList: %v", err)
+	}
+	for i := range links {
+		if links[i].Name == name {
+			return &links[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no interface %q in LinkList %v", name, links)
+}
+
+// newTestLink creates a dummy interface named name, or one end of a veth
+// pair if the dummy driver is not available.
+func newTestLink(name string) (*unix.LinkAttrs, error) {
+	err := unix.CreateDummyInterface(name)
+	if err == unix.EOPNOTSUPP {
+		err = unix.CreateVethPair(name, name+"-peer")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating %s: %v", name, err)
+	}
+	return findLink(name)
+}
+
+func TestLinkList(t *testing.T) {
+	lo, err := net.InterfaceByName("lo")
+	if err != nil {
+		t.Skipf("no loopback interface: %v", err)
+	}
+	l, err := findLink("lo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Index != lo.Index {
+		t.Errorf("lo index = %d, want %d", l.Index, lo.Index)
+	}
+	if l.MTU != lo.MTU {
+		t.Errorf("lo MTU = %d, want %d", l.MTU, lo.MTU)
+	}
+	if l.Flags&unix.IFF_LOOPBACK == 0 {
+		t.Errorf("lo flags = %#x, want IFF_LOOPBACK set", l.Flags)
+	}
+}
+
+func TestCreateVethPair(t *testing.T) {
+	runNetnsHelper(t, func() error {
+		if err := enterNewNetns(); err != nil {
+			return err
+		}
+		if err := unix.CreateVethPair("veth-a", "veth-b"); err != nil {
+			return fmt.Errorf("CreateVethPair: %v", err)
+		}
+		for _, name := range []string{"veth-a", "veth-b"} {
+			l, err := findLink(name)
+			if err != nil {
+				return err
+			}
+			if l.Kind != "veth" {
+				return fmt.Errorf("%s kind = %q, want veth", name, l.Kind)
+			}
+		}
+		if err := unix.CreateVethPair("veth-a", "veth-c"); err != unix.EEXIST {
+			return fmt.Errorf("CreateVethPair with an existing name: got %v, want EEXIST", err)
+		}
+		return nil
+	})
+}
+
+func TestLinkSetNsFd(t *testing.T) {
+	runNetnsHelper(t, func() error {
+		if err := enterNewNetns(); err != nil {
+			return err
+		}
+		if err := unix.CreateVethPair("veth-a", "veth-b"); err != nil {
+			return fmt.Errorf("CreateVethPair: %v", err)
+		}
+		l, err := findLink("veth-b")
+		if err != nil {
+			return err
+		}
+
+		// Create the target namespace from another thread, which keeps it
+		// alive through the returned file descriptor.
+		type result struct {
+			fd  int
+			err error
+		}
+		resc := make(chan result, 1)
+		go func() {
+			restore, err := unix.NewNetworkNamespace()
+			if err != nil {
+				resc <- result{err: fmt.Errorf("NewNetworkNamespace: %v", err)}
+				return
+			}
+			fd, err := unix.Open("/proc/thread-self/ns/net", unix.O_RDONLY|unix.O_CLOEXEC, 0)
+			if rerr := restore(); err == nil && rerr != nil {
+				err = fmt.Errorf("restore: %v", rerr)
+			}
+			resc <- result{fd, err}
+		}()
+		res := <-resc
+		if res.err != nil {
+			return res.err
+		}
+		defer unix.Close(res.fd)
+
+		if err := unix.LinkSetNsFd(l.Index, res.fd); err != nil {
+			return fmt.Errorf("LinkSetNsFd: %v", err)
+		}
+		if _, err := findLink("veth-b"); err == nil {
+			return fmt.Errorf("veth-b still in LinkList after LinkSetNsFd")
+		}
+		if _, err := findLink("veth-a"); err != nil {
+			return err
+		}
+
+		go func() {
+			// The thread is left in the target namespace and exits with
+			// the goroutine.
+			runtime.LockOSThread()
+			if err := unix.Setns(res.fd, unix.CLONE_NEWNET); err != nil {
+				resc <- result{err: fmt.Errorf("Setns: %v", err)}
+				return
+			}
+			_, err := findLink("veth-b")
+			resc <- result{err: err}
+		}()
+		return (<-resc).err
+	})
+}
+
+// hasAddr reports whether AddrList lists ip/prefixLen on the interface.
+func hasAddr(index int, ip net.IP, prefixLen int) (bool, error) {
+	addrs, err := unix.AddrList(index)
+	if err != nil {
+		return false, fmt.Errorf("AddrList: %v", err)
+	}
+	for _, a := range addrs {
+		if a.Index != index {
+			return false, fmt.Errorf("AddrList(%d) returned an address of interface %d", index, a.Index)
+		}
+		if a.IP.Equal(ip) && a.PrefixLen == prefixLen {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestAddrAdd(t *testing.T) {
+	runNetnsHelper(t, func() error {
+		if err := enterNewNetns(); err != nil {
+			return err
+		}
+		if err := unix.CreateVethPair("veth-a", "veth-b"); err != nil {
+			return fmt.Errorf("CreateVethPair: %v", err)
+		}
+		l, err := findLink("veth-a")
+		if err != nil {
+			return err
+		}
+		ip := net.IPv4(10, 0, 0, 1)
+		if err := unix.AddrAdd(l.Index, ip, 24); err != nil {
+			return fmt.Errorf("AddrAdd: %v", err)
+		}
+		if ok, err := hasAddr(l.Index, ip, 24); err != nil || !ok {
+			return fmt.Errorf("10.0.0.1/24 not listed after AddrAdd (%v)", err)
+		}
+		if err := unix.AddrAdd(l.Index, ip, 24); err != unix.EEXIST {
+			return fmt.Errorf("AddrAdd of an existing address: got %v, want EEXIST", err)
+		}
+		if err := unix.AddrDel(l.Index, ip, 24); err != nil {
+			return fmt.Errorf("AddrDel: %v", err)
+		}
+		if ok, err := hasAddr(l.Index, ip, 24); err != nil || ok {
+			return fmt.Errorf("10.0.0.1/24 still listed after AddrDel (%v)", err)
+		}
+		if err := unix.AddrAdd(l.Index, ip, 33); err != unix.EINVAL {
+			return fmt.Errorf("AddrAdd with prefix length 33: got %v, want EINVAL", err)
+		}
+		return nil
+	})
+}
+
+func TestAddrList(t *testing.T) {
+	lo, err := net.InterfaceByName("lo")
+	if err != nil {
+		t.Skipf("no loopback interface: %v", err)
+	}
+	ok, err := hasAddr(lo.Index, net.IPv4(127, 0, 0, 1), 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Skip("127.0.0.1/8 is not assigned to lo")
+	}
+}
+
+func TestCreateDummyInterface(t *testing.T) {
+	runNetnsHelper(t, func() error {
+		if err := enterNewNetns(); err != nil {
+			return err
+		}
+		if err := unix.CreateDummyInterface("dummy0"); err == unix.EOPNOTSUPP {
+			return fmt.Errorf("skip: dummy driver not available: %v", err)
+		} else if err != nil {
+			return fmt.Errorf("CreateDummyInterface: %v", err)
+		}
+		for _, name := range []string{"lo", "dummy0"} {
+			l, err := findLink(name)
+			if err != nil {
+				return err
+			}
+			if err := unix.LinkSetUp(l.Index); err != nil {
+				return fmt.Errorf("LinkSetUp(%s): %v", name, err)
+			}
+		}
+		l, err := findLink("dummy0")
+		if err != nil {
+			return err
+		}
+		if l.Kind != "dummy" || l.Flags&unix.IFF_UP == 0 {
+			return fmt.Errorf("dummy0 kind %q flags %#x, want up dummy", l.Kind, l.Flags)
+		}
+		if err := unix.AddrAdd(l.Index, net.IPv4(10, 1, 0, 1), 24); err != nil {
+			return fmt.Errorf("AddrAdd: %v", err)
+		}
+
+		fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+		if err != nil {
+			return err
+		}
+		defer unix.Close(fd)
+		if err := unix.Bind(fd, &unix.SockaddrInet4{Addr: [4]byte{10, 1, 0, 1}}); err != nil {
+			return fmt.Errorf("Bind: %v", err)
+		}
+		sa, err := unix.Getsockname(fd)
+		if err != nil {
+			return err
+		}
+		if err := unix.Sendto(fd, []byte("ping"), 0, sa); err != nil {
+			return fmt.Errorf("Sendto: %v", err)
+		}
+		buf := make([]byte, 8)
+		n, _, err := unix.Recvfrom(fd, buf, unix.MSG_DONTWAIT)
+		if err != nil || string(buf[:n]) != "ping" {
+			return fmt.Errorf("Recvfrom = %q, %v; want ping", buf[:n], err)
+		}
+
+		if err := unix.LinkSetDown(l.Index); err != nil {
+			return fmt.Errorf("LinkSetDown: %v", err)
+		}
+		if l, err = findLink("dummy0"); err != nil || l.Flags&unix.IFF_UP != 0 {
+			return fmt.Errorf("dummy0 still up after LinkSetDown (%v)", err)
+		}
+		return nil
+	})
+}
+
+func TestLinkSetMTU(t *testing.T) {
+	runNetnsHelper(t, func() error {
+		if err := enterNewNetns(); err != nil {
+			return err
+		}
+		l, err := newTestLink("test0")
+		if err != nil {
+			return err
+		}
+		mac := net.HardwareAddr{0x02, 0x00, 0x5e, 0x10, 0x20, 0x30}
+		if err := unix.LinkSetMTU(l.Index, 1400); err != nil {
+			return fmt.Errorf("LinkSetMTU: %v", err)
+		}
+		if err := unix.LinkSetHardwareAddr(l.Index, mac); err != nil {
+			return fmt.Errorf("LinkSetHardwareAddr: %v", err)
+		}
+		if l, err = findLink("test0"); err != nil {
+			return err
+		}
+		if l.MTU != 1400 {
+			return fmt.Errorf("MTU = %d, want 1400", l.MTU)
+		}
+		if l.HardwareAddr.String() != mac.String() {
+			return fmt.Errorf("HardwareAddr = %v, want %v", l.HardwareAddr, mac)
+		}
+		if err := unix.LinkSetMTU(l.Index, 0); err != unix.EINVAL {
+			return fmt.Errorf("LinkSetMTU(0): got %v, want EINVAL", err)
+		}
+		return nil
+	})
+}
+
+func TestSetMaster(t *testing.T) {
+	runNetnsHelper(t, func() error {
+		if err := enterNewNetns(); err != nil {
+			return err
+		}
+		if err := unix.CreateBridge("br0"); err == unix.EOPNOTSUPP {
+			return fmt.Errorf("skip: bridge driver not available: %v", err)
+		} else if err != nil {
+			return fmt.Errorf("CreateBridge: %v", err)
+		}
+		br, err := findLink("br0")
+		if err != nil {
+			return err
+		}
+		if br.Kind != "bridge" {
+			return fmt.Errorf("br0 kind = %q, want bridge", br.Kind)
+		}
+		port, err := newTestLink("port0")
+		if err != nil {
+			return err
+		}
+		if err := unix.SetMaster(port.Index, br.Index); err != nil {
+			return fmt.Errorf("SetMaster: %v", err)
+		}
+		if port, err = findLink("port0"); err != nil {
+			return err
+		}
+		if port.MasterIndex != br.Index {
+			return fmt.Errorf("port0 master = %d, want %d", port.MasterIndex, br.Index)
+		}
+		if err := unix.SetMaster(port.Index, 0); err != nil {
+			return fmt.Errorf("SetMaster(0): %v", err)
+		}
+		if port, err = findLink("port0"); err != nil || port.MasterIndex != 0 {
+			return fmt.Errorf("port0 still attached after SetMaster(0) (%v)", err)
+		}
+		return nil
+	})
+}
+
+func TestLinkStats(t *testing.T) {
+	lo, err := net.InterfaceByName("lo")
+	if err != nil || lo.Flags&net.FlagUp == 0 {
+		t.Skip("no loopback interface that is up")
+	}
+	before, err := unix.LinkStats(lo.Index)
+	if err != nil {
+		t.Fatalf("LinkStats: %v", err)
+	}
+
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+	if err := unix.Bind(fd, &unix.SockaddrInet4{Addr: [4]byte{127, 0, 0, 1}}); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+	sa, err := unix.Getsockname(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const n = 1000
+	if err := unix.Sendto(fd, make([]byte, n), 0, sa); err != nil {
+		t.Fatalf("Sendto: %v", err)
+	}
+
+	after, err := unix.LinkStats(lo.Index)
+	if err != nil {
+		t.Fatalf("LinkStats: %v", err)
+	}
+	if after.Rx_bytes < before.Rx_bytes+n {
+		t.Errorf("Rx_bytes = %d after sending %d bytes, was %d", after.Rx_bytes, n, before.Rx_bytes)
+	}
+	if after.Rx_packets <= before.Rx_packets {
+		t.Errorf("Rx_packets = %d, was %d", after.Rx_packets, before.Rx_packets)
+	}
+	if _, err := unix.LinkStats(1 << 30); err != unix.ENODEV {
+		t.Errorf("LinkStats of a missing interface: got %v, want ENODEV", err)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestSchedInfo(t *testing.T) {
+	policy, priority, nice, err := unix.SchedInfo(0)
+	if err != nil {
+		t.Fatalf("SchedInfo: %v", err)
+	}
+	t.Logf("policy %d, priority %d, nice %d", policy, priority, nice)
+	if policy != unix.SCHED_NORMAL {
+		t.Skipf("test process runs with policy %d, want SCHED_NORMAL", policy)
+	}
+	if priority != 0 {
+		t.Errorf("SCHED_NORMAL thread has static priority %d, want 0", priority)
+	}
+	attr, err := unix.SchedGetAttr(0, 0)
+	if err != nil {
+		t.Fatalf("SchedGetAttr: %v", err)
+	}
+	if nice != int(attr.Nice) {
+		t.Errorf("SchedInfo nice = %d, sched_getattr reports %d", nice, attr.Nice)
+	}
+	if nice >= 19 {
+		t.Skip("cannot lower the priority of an already maximally niced process")
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		// The nice value of this thread is changed, so never unlock it.
+		runtime.LockOSThread()
+		errc <- func() error {
+			tid := unix.Gettid()
+			if err := unix.Setpriority(unix.PRIO_PROCESS, tid, nice+1); err != nil {
+				return fmt.Errorf("Setpriority: %v", err)
+			}
+			_, _, got, err := unix.SchedInfo(tid)
+			if err != nil {
+				return fmt.Errorf("SchedInfo(%d): %v", tid, err)
+			}
+			if got != nice+1 {
+				return fmt.Errorf("SchedInfo nice = %d after Setpriority(%d)", got, nice+1)
+			}
+			return nil
+		}()
+	}()
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSchedRRGetInterval(t *testing.T) {
+	d, err := unix.SchedRRGetInterval(0)
+	if err != nil {
+		t.Fatalf("SchedRRGetInterval: %v", err)
+	}
+	t.Logf("time slice %v", d)
+	if d < 0 {
+		t.Errorf("SchedRRGetInterval = %v, want a non-negative duration", d)
+	}
+}
+
+func TestSetDeadlineScheduling(t *testing.T) {
+	ms := time.Millisecond
+	for _, band := range [][3]time.Duration{{0, ms, ms}, {2 * ms, ms, 10 * ms}, {ms, 10 * ms, 5 * ms}} {
+		if err := unix.SetDeadlineScheduling(band[0], band[1], band[2]); err != unix.EINVAL {
+			t.Errorf("SetDeadlineScheduling(%v, %v, %v): got %v, want EINVAL", band[0], band[1], band[2], err)
+		}
+	}
+
+	// This changes the scheduling policy of the thread.
+	runOnLockedThread(t, func() (bool, error) {
+		if err := unix.SetDeadlineScheduling(ms, 10*ms, 100*ms); err != nil {
+			return err == unix.EPERM || err == unix.EBUSY, fmt.Errorf("SetDeadlineScheduling: %v", err)
+		}
+		attr, err := unix.SchedGetAttr(0, 0)
+		if err != nil {
+			return false, fmt.Errorf("SchedGetAttr: %v", err)
+		}
+		if attr.Policy != unix.SCHED_DEADLINE || attr.Runtime != uint64(ms) || attr.Deadline != uint64(10*ms) || attr.Period != uint64(100*ms) {
+			return false, fmt.Errorf("sched_getattr reports %+v, want the SCHED_DEADLINE band", *attr)
+		}
+		if attr.Flags&unix.SCHED_FLAG_RESET_ON_FORK == 0 {
+			return false, fmt.Errorf("SCHED_FLAG_RESET_ON_FORK not set: flags %#x", attr.Flags)
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,241 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// seccomp filters and user notifications, see seccomp(2) and
+// seccomp_unotify(2).
+
+package unix
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// SeccompNotifRecv waits for the next system call intercepted by a filter
+// returning SECCOMP_RET_USER_NOTIF and returns its description, using the
+// SECCOMP_IOCTL_NOTIF_RECV ioctl on the notification fd. The intercepted
+// thread stays blocked until SeccompNotifSend is called with a response
+// carrying the same Id.
+func SeccompNotifRecv(fd int) (*SeccompNotif, error) {
+	// The kernel requires the buffer to be zeroed.
+	var notif SeccompNotif
+	if err := ioctlPtr(fd, SECCOMP_IOCTL_NOTIF_RECV, unsafe.Pointer(&notif)); err != nil {
+		return nil, err
+	}
+	return &notif, nil
+}
+
+// SeccompNotifSend completes the intercepted system call identified by
+// resp.Id, using the SECCOMP_IOCTL_NOTIF_SEND ioctl. The system call returns
+// resp.Val, or fails with the negated errno value in resp.Error if it is
+// non-zero. If resp.Flags contains SECCOMP_USER_NOTIF_FLAG_CONTINUE, the
+// system call is instead executed by the kernel as usual.
+//
+// It returns ENOENT if the target was interrupted by a signal or has died
+// since the notification was received.
+func SeccompNotifSend(fd int, resp *SeccompNotifResp) error {
+	return ioctlPtr(fd, SECCOMP_IOCTL_NOTIF_SEND, unsafe.Pointer(resp))
+}
+
+// SeccompNotifIDValid checks, using the SECCOMP_IOCTL_NOTIF_ID_VALID ioctl,
+// that the notification id is still pending, that is, that the target is
+// still blocked in the intercepted system call. It returns ENOENT if the
+// notification is no longer valid. Supervisors must check this after
+// reading from the target's memory and before acting on what was read, as
+// the target may otherwise have been replaced by another process.
+func SeccompNotifIDValid(fd int, id uint64) error {
+	return ioctlPtr(fd, SECCOMP_IOCTL_NOTIF_ID_VALID, unsafe.Pointer(&id))
+}
+
+// IoctlSeccompNotifAddfd installs a duplicate of the supervisor's file
+// descriptor addfd.Srcfd in the target of the pending notification
+// addfd.Id, using the SECCOMP_IOCTL_NOTIF_ADDFD ioctl on the notification
+// fd, and returns the number of the new file descriptor in the target.
+//
+// With SECCOMP_ADDFD_FLAG_SETFD the descriptor is installed as addfd.Newfd;
+// addfd.Newfd_flags may contain O_CLOEXEC. With SECCOMP_ADDFD_FLAG_SEND
+// (Linux >= 5.14) the notification is also answered, atomically, with the
+// new descriptor as the return value of the intercepted system call, so
+// SeccompNotifSend must not be called for it.
+func IoctlSeccompNotifAddfd(fd int, addfd *SeccompNotifAddfd) (int, error) {
+	ret, _, err := Syscall(SYS_IOCTL, uintptr(fd), SECCOMP_IOCTL_NOTIF_ADDFD, uintptr(unsafe.Pointer(addfd)))
+	if err != 0 {
+		return -1, err
+	}
+	return int(ret), nil
+}
+
+// IoctlSeccompNotifSetFlags sets the SECCOMP_USER_NOTIF_FD_* flags of the
+// notification fd with the SECCOMP_IOCTL_NOTIF_SET_FLAGS ioctl (Linux >=
+// 6.6). SECCOMP_USER_NOTIF_FD_SYNC_WAKE_UP asks the kernel to wake the
+// supervisor on the CPU of the target, which speeds up supervisors that
+// answer each notification synchronously.
+func IoctlSeccompNotifSetFlags(fd int, flags uint64) error {
+	return ioctl(fd, SECCOMP_IOCTL_NOTIF_SET_FLAGS, uintptr(flags))
+}
+
+// SeccompGetNotifSizes returns the sizes of the notification structures
+// used by the running kernel, with SECCOMP_GET_NOTIF_SIZES.
+func SeccompGetNotifSizes() (*SeccompNotifSizes, error) {
+	var sizes SeccompNotifSizes
+	if _, err := seccomp(SECCOMP_GET_NOTIF_SIZES, 0, unsafe.Pointer(&sizes)); err != nil {
+		return nil, err
+	}
+	return &sizes, nil
+}
+
+// SeccompSetModeFilterListener installs the seccomp filter prog with
+// SECCOMP_SET_MODE_FILTER and SECCOMP_FILTER_FLAG_NEW_LISTENER in addition
+// to flags, and returns the notification fd on which the system calls for
+// which prog returns SECCOMP_RET_USER_NOTIF are reported. The fd is opened
+// with O_CLOEXEC and can be passed to a supervisor, for example with
+// UnixRights.
+//
+// The caller must either have CAP_SYS_ADMIN or have set no_new_privs with
+// PR_SET_NO_NEW_PRIVS. Without SECCOMP_FILTER_FLAG_TSYNC the filter only
+// applies to the calling thread and threads it creates later, so callers
+// should use runtime.LockOSThread.
+func SeccompSetModeFilterListener(flags uint, prog *SockFprog) (listenerFd int, err error) {
+	return seccomp(SECCOMP_SET_MODE_FILTER, flags|SECCOMP_FILTER_FLAG_NEW_LISTENER, unsafe.Pointer(prog))
+}
+
+// SeccompSetModeFilter installs the seccomp filter prog with
+// SECCOMP_SET_MODE_FILTER and the SECCOMP_FILTER_FLAG_* flags, with the
+// same requirements as SeccompSetModeFilterListener.
+func SeccompSetModeFilter(flags uint, prog *SockFprog) error {
+	_, err := seccomp(SECCOMP_SET_MODE_FILTER, flags, unsafe.Pointer(prog))
+	return err
+}
+
+// SeccompRetErrno returns the SECCOMP_RET_ERRNO action that makes a system
+// call fail with errno.
+func SeccompRetErrno(errno Errno) uint32 {
+	return SECCOMP_RET_ERRNO | uint32(errno)&SECCOMP_RET_DATA
+}
+
+// NativeAuditArch returns the AUDIT_ARCH_* value identifying the system
+// call convention of the running program, as found in SeccompData.Arch.
+func NativeAuditArch() uint32 {
+	switch runtime.GOARCH {
+	case "386":
+		return AUDIT_ARCH_I386
+	case "amd64":
+		return AUDIT_ARCH_X86_64
+	case "arm":
+		return AUDIT_ARCH_ARM
+	case "arm64":
+		return AUDIT_ARCH_AARCH64
+	case "loong64":
+		return AUDIT_ARCH_LOONGARCH64
+	case "mips":
+		return AUDIT_ARCH_MIPS
+	case "mipsle":
+		return AUDIT_ARCH_MIPSEL
+	case "mips64":
+		return AUDIT_ARCH_MIPS64
+	case "mips64le":
+		return AUDIT_ARCH_MIPSEL64
+	case "ppc":
+		return AUDIT_ARCH_PPC
+	case "ppc64":
+		return AUDIT_ARCH_PPC64
+	case "ppc64le":
+		return AUDIT_ARCH_PPC64LE
+	case "riscv64":
+		return AUDIT_ARCH_RISCV64
+	case "s390x":
+		return AUDIT_ARCH_S390X
+	case "sparc64":
+		return AUDIT_ARCH_SPARC64
+	}
+	return 0
+}
+
+// SeccompRule makes a SeccompFilter return Action, one of the
+// SECCOMP_RET_* actions, for the system call with number Syscall.
+type SeccompRule struct {
+	Syscall uintptr
+	Action  uint32
+}
+
+// SeccompFilter describes a seccomp filter that returns the action of the
+// first rule matching the system call number, or DefaultAction if none
+// does. System calls made with another calling convention than the native
+// one, such as 32-bit calls from a 64-bit program, are not matched by
+// number: they return ForeignArchAction, or SECCOMP_RET_KILL_PROCESS if it
+// is zero.
+//
+// Unlike ForeignArchAction, a zero DefaultAction is used as is: it is
+// SECCOMP_RET_KILL_THREAD, so a filter that should let unmatched system
+// calls through must set DefaultAction to SECCOMP_RET_ALLOW.
+type SeccompFilter struct {
+	Rules             []SeccompRule
+	DefaultAction     uint32
+	ForeignArchAction uint32
+}
+
+// x32SyscallBit is set in the numbers of x32 system calls, which share the
+// AUDIT_ARCH_X86_64 convention with the native amd64 ones.
+const x32SyscallBit = 0x40000000
+
+// Assemble returns the classic BPF program implementing the filter. It
+// returns EINVAL if the running architecture is not known or if the
+// program is too long.
+func (f *SeccompFilter) Assemble() (BPFInstructions, error) {
+	arch := NativeAuditArch()
+	if arch == 0 {
+		return nil, EINVAL
+	}
+	foreign := f.ForeignArchAction
+	if foreign == 0 {
+		foreign = SECCOMP_RET_KILL_PROCESS
+	}
+	offArch := uint32(unsafe.Offsetof(SeccompData{}.Arch))
+	offNr := uint32(unsafe.Offsetof(SeccompData{}.Nr))
+
+	prog := BPFInstructions{
+		BPFStmt(BPF_LD|BPF_W|BPF_ABS, offArch),
+		BPFJump(BPF_JMP|BPF_JEQ|BPF_K, arch, 1, 0),
+		BPFStmt(BPF_RET|BPF_K, foreign),
+		BPFStmt(BPF_LD|BPF_W|BPF_ABS, offNr),
+	}
+	if arch == AUDIT_ARCH_X86_64 {
+		prog = append(prog,
+			BPFJump(BPF_JMP|BPF_JGE|BPF_K, x32SyscallBit, 0, 1),
+			BPFStmt(BPF_RET|BPF_K, foreign),
+		)
+	}
+	for _, r := range f.Rules {
+		prog = append(prog,
+			BPFJump(BPF_JMP|BPF_JEQ|BPF_K, uint32(r.Syscall), 0, 1),
+			BPFStmt(BPF_RET|BPF_K, r.Action),
+		)
+	}
+	prog = append(prog, BPFStmt(BPF_RET|BPF_K, f.DefaultAction))
+	if len(prog) > BPF_MAXINSNS {
+		return nil, EINVAL
+	}
+	return prog, nil
+}
+
+// Install sets no_new_privs with PR_SET_NO_NEW_PRIVS and installs the
+// filter with SeccompSetModeFilter and the SECCOMP_FILTER_FLAG_* flags.
+// Neither can be undone. Unless flags contains SECCOMP_FILTER_FLAG_TSYNC,
+// which applies both to every thread of the process, they only apply to
+// the calling thread and the threads and processes it creates later, so
+// callers should use runtime.LockOSThread.
+func (f *SeccompFilter) Install(flags uint) error {
+	insns, err := f.Assemble()
+	if err != nil {
+		return err
+	}
+	prog, err := insns.SockFprog()
+	if err != nil {
+		return err
+	}
+	if err := Prctl(PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return err
+	}
+	return SeccompSetModeFilter(flags, prog)
+}
@@ -0,0 +1,384 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// notifyGetppid is a seccomp filter that sends a user notification for
+// getppid(2) and allows every other system call.
+var notifyGetppid = unix.BPFInstructions{
+	unix.BPFStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, 0), // seccomp_data.nr
+	unix.BPFJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.SYS_GETPPID, 0, 1),
+	unix.BPFStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_USER_NOTIF),
+	unix.BPFStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ALLOW),
+}
+
+// startNotifyThread installs notifyGetppid on a new, locked thread, which
+// then calls getppid. It returns the notification fd and a channel
+// receiving the result of getppid. The thread is never unlocked, so it
+// exits along with its filter once getppid returns.
+func startNotifyThread(t *testing.T) (listener int, result <-chan uintptr) {
+	t.Helper()
+	fds := make(chan int, 1)
+	errs := make(chan error, 1)
+	res := make(chan uintptr, 1)
+	go func() {
+		runtime.LockOSThread()
+		if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+			errs <- err
+			return
+		}
+		prog, err := notifyGetppid.SockFprog()
+		if err != nil {
+			errs <- err
+			return
+		}
+		fd, err := unix.SeccompSetModeFilterListener(0, prog)
+		if err != nil {
+			errs <- err
+			return
+		}
+		fds <- fd
+		// Use Syscall, not RawSyscall, so that the runtime does not wait
+		// for this thread while it is blocked in the kernel.
+		r, _, _ := unix.Syscall(unix.SYS_GETPPID, 0, 0, 0)
+		res <- r
+	}()
+	select {
+	case fd := <-fds:
+		return fd, res
+	case err := <-errs:
+		t.Skipf("cannot install seccomp filter: %v", err)
+		panic("unreachable")
+	}
+}
+
+func TestSeccompNotif(t *testing.T) {
+	fd, result := startNotifyThread(t)
+	// Closing the listener fails any pending notification with ENOSYS,
+	// so the thread never stays blocked if the test fails.
+	defer unix.Close(fd)
+
+	// SECCOMP_IOCTL_NOTIF_SET_FLAGS is unknown before Linux 6.6.
+	if err := unix.IoctlSeccompNotifSetFlags(fd, unix.SECCOMP_USER_NOTIF_FD_SYNC_WAKE_UP); err != nil && err != unix.EINVAL {
+		t.Errorf("IoctlSeccompNotifSetFlags: %v", err)
+	}
+
+	notif, err := unix.SeccompNotifRecv(fd)
+	if err != nil {
+		t.Fatalf("SeccompNotifRecv: %v", err)
+	}
+	if notif.Data.Nr != unix.SYS_GETPPID {
+		t.Errorf("notification for system call %d, want %d", notif.Data.Nr, unix.SYS_GETPPID)
+	}
+	if int(notif.Pid) == 0 {
+		t.Error("notification has no pid")
+	}
+	if err := unix.SeccompNotifIDValid(fd, notif.Id); err != nil {
+		t.Errorf("SeccompNotifIDValid: %v", err)
+	}
+
+	if err := unix.SeccompNotifSend(fd, &unix.SeccompNotifResp{Id: notif.Id, Val: 42}); err != nil {
+		t.Fatalf("SeccompNotifSend: %v", err)
+	}
+	if r := <-result; r != 42 {
+		t.Errorf("intercepted getppid returned %d, want 42", r)
+	}
+	if err := unix.SeccompNotifIDValid(fd, notif.Id); err != unix.ENOENT {
+		t.Errorf("SeccompNotifIDValid after response: got %v, want ENOENT", err)
+	}
+}
+
+func TestSeccompGetNotifSizes(t *testing.T) {
+	sizes, err := unix.SeccompGetNotifSizes()
+	if err != nil {
+		t.Skipf("SeccompGetNotifSizes: %v", err)
+	}
+	if sizes.Seccomp_data != unix.SizeofSeccompData {
+		t.Errorf("seccomp_data size: got %d, want %d", sizes.Seccomp_data, unix.SizeofSeccompData)
+	}
+	if sizes.Seccomp_notif < unix.SizeofSeccompNotif || sizes.Seccomp_notif_resp < unix.SizeofSeccompNotifResp {
+		t.Errorf("notification sizes %+v smaller than SeccompNotif and SeccompNotifResp", *sizes)
+	}
+}
+
+func TestSeccompSetModeFilterListener(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") == "1" {
+		seccompListenerChild()
+		return
+	}
+
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Socketpair: %v", err)
+	}
+	defer unix.Close(fds[0])
+	childSock := os.NewFile(uintptr(fds[1]), "child")
+	defer childSock.Close()
+
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(exe, "-test.run=^TestSeccompSetModeFilterListener$")
+	cmd.Env = append(os.Environ(), "GO_WANT_HELPER_PROCESS=1")
+	cmd.ExtraFiles = []*os.File{childSock}
+	var out strings.Builder
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	childSock.Close()
+
+	oob := make([]byte, unix.CmsgSpace(4))
+	_, oobn, _, _, err := unix.Recvmsg(fds[0], make([]byte, 1), oob, 0)
+	if err != nil || oobn == 0 {
+		cmd.Wait()
+		if strings.HasPrefix(out.String(), "skip:") {
+			t.Skip(strings.TrimPrefix(out.String(), "skip:"))
+		}
+		t.Fatalf("Recvmsg: %v; child output %q", err, out.String())
+	}
+	msgs, err := unix.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		t.Fatalf("ParseSocketControlMessage: %v", err)
+	}
+	rights, err := unix.ParseUnixRights(&msgs[0])
+	if err != nil {
+		t.Fatalf("ParseUnixRights: %v", err)
+	}
+	listener := rights[0]
+	defer unix.Close(listener)
+
+	notif, err := unix.SeccompNotifRecv(listener)
+	if err != nil {
+		t.Fatalf("SeccompNotifRecv: %v", err)
+	}
+	if err := unix.SeccompNotifSend(listener, &unix.SeccompNotifResp{Id: notif.Id, Val: 42}); err != nil {
+		t.Fatalf("SeccompNotifSend: %v", err)
+	}
+	if err := cmd.Wait(); err != nil {
+		t.Fatalf("child: %v; output %q", err, out.String())
+	}
+	if got := strings.TrimSpace(out.String()); got != "getppid 42" {
+		t.Errorf("child output %q, want %q", got, "getppid 42")
+	}
+}
+
+// seccompListenerChild installs notifyGetppid, sends the listener fd over
+// fd 3 and reports the result of getppid.
+func seccompListenerChild() {
+	runtime.LockOSThread()
+	defer os.Exit(0)
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		fmt.Printf("skip:PR_SET_NO_NEW_PRIVS: %v", err)
+		return
+	}
+	prog, err := notifyGetppid.SockFprog()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	fd, err := unix.SeccompSetModeFilterListener(0, prog)
+	if err != nil {
+		fmt.Printf("skip:SeccompSetModeFilterListener: %v", err)
+		return
+	}
+	if err := unix.Sendmsg(3, []byte{0}, unix.UnixRights(fd), nil, 0); err != nil {
+		fmt.Printf("Sendmsg: %v\n", err)
+		os.Exit(1)
+	}
+	unix.Close(fd)
+	r, _, _ := unix.Syscall(unix.SYS_GETPPID, 0, 0, 0)
+	fmt.Println("getppid", r)
+}
+
+func TestIoctlSeccompNotifAddfd(t *testing.T) {
+	notifyOpenat := unix.BPFInstructions{
+		unix.BPFStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, 0), // seccomp_data.nr
+		unix.BPFJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.SYS_OPENAT, 0, 1),
+		unix.BPFStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_USER_NOTIF),
+		unix.BPFStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ALLOW),
+	}
+
+	var p [2]int
+	if err := unix.Pipe2(p[:], unix.O_CLOEXEC); err != nil {
+		t.Fatalf("Pipe2: %v", err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+
+	type result struct {
+		fd  int
+		err error
+	}
+	fds := make(chan int, 1)
+	errs := make(chan error, 1)
+	res := make(chan result, 1)
+	go func() {
+		// The filter stays with this thread, which exits when the
+		// goroutine returns since it is never unlocked.
+		runtime.LockOSThread()
+		if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+			errs <- err
+			return
+		}
+		prog, err := notifyOpenat.SockFprog()
+		if err != nil {
+			errs <- err
+			return
+		}
+		fd, err := unix.SeccompSetModeFilterListener(0, prog)
+		if err != nil {
+			errs <- err
+			return
+		}
+		fds <- fd
+		fd, err = unix.Openat(unix.AT_FDCWD, "/nonexistent/replaced-by-supervisor", unix.O_RDONLY|unix.O_CLOEXEC, 0)
+		res <- result{fd, err}
+	}()
+	var listener int
+	select {
+	case listener = <-fds:
+	case err := <-errs:
+		t.Skipf("cannot install seccomp filter: %v", err)
+	}
+	defer unix.Close(listener)
+
+	notif, err := unix.SeccompNotifRecv(listener)
+	if err != nil {
+		t.Fatalf("SeccompNotifRecv: %v", err)
+	}
+	if notif.Data.Nr != unix.SYS_OPENAT {
+		t.Fatalf("notification for system call %d, want %d", notif.Data.Nr, unix.SYS_OPENAT)
+	}
+	newfd, err := unix.IoctlSeccompNotifAddfd(listener, &unix.SeccompNotifAddfd{
+		Id:          notif.Id,
+		Flags:       unix.SECCOMP_ADDFD_FLAG_SEND,
+		Srcfd:       uint32(p[1]),
+		Newfd_flags: unix.O_CLOEXEC,
+	})
+	if err == unix.EINVAL {
+		// SECCOMP_ADDFD_FLAG_SEND needs Linux 5.14; fail the open instead.
+		unix.SeccompNotifSend(listener, &unix.SeccompNotifResp{Id: notif.Id, Error: -int32(unix.ENOSYS)})
+		<-res
+		t.Skip("SECCOMP_ADDFD_FLAG_SEND not supported")
+	}
+	if err != nil {
+		t.Fatalf("IoctlSeccompNotifAddfd: %v", err)
+	}
+
+	r := <-res
+	if r.err != nil {
+		t.Fatalf("intercepted Openat: %v", r.err)
+	}
+	defer unix.Close(r.fd)
+	if r.fd != newfd {
+		t.Errorf("intercepted Openat returned fd %d, IoctlSeccompNotifAddfd returned %d", r.fd, newfd)
+	}
+	// The target received the write end of the pipe.
+	if _, err := unix.Write(r.fd, []byte("x")); err != nil {
+		t.Fatalf("Write to injected fd: %v", err)
+	}
+	buf := make([]byte, 1)
+	if n, err := unix.Read(p[0], buf); err != nil || n != 1 || buf[0] != 'x' {
+		t.Errorf("Read from pipe = %d, %v, %q; want 1 byte %q", n, err, buf[:max(n, 0)], "x")
+	}
+}
+
+func TestSeccompFilterAssemble(t *testing.T) {
+	if unix.NativeAuditArch() == 0 {
+		t.Skipf("no audit architecture for %s", runtime.GOARCH)
+	}
+	f := unix.SeccompFilter{
+		Rules: []unix.SeccompRule{
+			{Syscall: unix.SYS_UNAME, Action: unix.SeccompRetErrno(unix.EPERM)},
+			{Syscall: unix.SYS_GETPPID, Action: unix.SECCOMP_RET_TRAP},
+		},
+		DefaultAction: unix.SECCOMP_RET_ALLOW,
+	}
+	prog, err := f.Assemble()
+	if err != nil {
+		t.Fatalf("Assemble: %v", err)
+	}
+	if got := prog[1]; got.K != unix.NativeAuditArch() {
+		t.Errorf("architecture check: got %#x, want %#x", got.K, unix.NativeAuditArch())
+	}
+	if got := prog[2]; got.K != unix.SECCOMP_RET_KILL_PROCESS {
+		t.Errorf("foreign architecture action: got %#x, want SECCOMP_RET_KILL_PROCESS", got.K)
+	}
+	if got := prog[len(prog)-1]; got.K != unix.SECCOMP_RET_ALLOW {
+		t.Errorf("default action: got %#x, want SECCOMP_RET_ALLOW", got.K)
+	}
+	if got, want := prog[len(prog)-4].K, unix.SECCOMP_RET_ERRNO|uint32(unix.EPERM); got != want {
+		t.Errorf("action of the first rule: got %#x, want %#x", got, want)
+	}
+
+	f.DefaultAction = 0
+	prog, err = f.Assemble()
+	if err != nil {
+		t.Fatalf("Assemble: %v", err)
+	}
+	if got := prog[len(prog)-1]; got.K != unix.SECCOMP_RET_KILL_THREAD {
+		t.Errorf("zero default action: got %#x, want SECCOMP_RET_KILL_THREAD", got.K)
+	}
+
+	f.Rules = make([]unix.SeccompRule, unix.BPF_MAXINSNS/2)
+	if _, err := f.Assemble(); err != unix.EINVAL {
+		t.Errorf("Assemble of an oversized filter: got %v, want EINVAL", err)
+	}
+}
+
+func TestSeccompFilterInstall(t *testing.T) {
+	if unix.NativeAuditArch() == 0 {
+		t.Skipf("no audit architecture for %s", runtime.GOARCH)
+	}
+	f := unix.SeccompFilter{
+		Rules: []unix.SeccompRule{
+			{Syscall: unix.SYS_UNAME, Action: unix.SeccompRetErrno(unix.EPERM)},
+		},
+		DefaultAction: unix.SECCOMP_RET_ALLOW,
+	}
+	errc := make(chan error)
+	go func() {
+		runtime.LockOSThread()
+		// No UnlockOSThread: the thread exits along with its filter.
+		if err := f.Install(0); err != nil {
+			errc <- fmt.Errorf("Install: %v", err)
+			return
+		}
+		var uts unix.Utsname
+		if err := unix.Uname(&uts); err != unix.EPERM {
+			errc <- fmt.Errorf("filtered Uname: got %v, want EPERM", err)
+			return
+		}
+		if _, err := unix.Getcwd(make([]byte, unix.PathMax)); err != nil {
+			errc <- fmt.Errorf("unfiltered Getcwd: %v", err)
+			return
+		}
+		errc <- nil
+	}()
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+
+	// Other threads are not filtered.
+	var uts unix.Utsname
+	if err := unix.Uname(&uts); err != nil {
+		t.Errorf("Uname on an unfiltered thread: %v", err)
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+// The range of real-time signals as defined by the kernel. C libraries
+// reserve the first few of them for internal use and report a higher
+// SIGRTMIN to programs, but SignalName and SignalNum use the kernel's
+// numbering.
+const (
+	sigrtmin = 32
+	sigrtmax = _C__NSIG - 1
+)
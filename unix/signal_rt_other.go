@@ -0,0 +1,13 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build aix || darwin || dragonfly || freebsd || netbsd || openbsd || solaris
+
+package unix
+
+// Real-time signals are only named on Linux; this range is empty.
+const (
+	sigrtmin = 1
+	sigrtmax = 0
+)
@@ -0,0 +1,55 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Manipulation of signal sets, see sigsetops(3).
+
+package unix
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// sigsetIndex returns the word of a Sigset_t holding sig and the bit
+// within that word.
+func sigsetIndex(set *Sigset_t, sig syscall.Signal) (word int, bit uint, err error) {
+	w := int(unsafe.Sizeof(set.Val[0])) * 8
+	if sig < 1 || sig > sigrtmax {
+		return 0, 0, EINVAL
+	}
+	n := int(sig - 1)
+	return n / w, uint(n % w), nil
+}
+
+// SigsetAdd adds the signal sig to set. It returns EINVAL if sig is not a
+// valid signal number.
+func SigsetAdd(set *Sigset_t, sig syscall.Signal) error {
+	i, b, err := sigsetIndex(set, sig)
+	if err != nil {
+		return err
+	}
+	set.Val[i] |= 1 << b
+	return nil
+}
+
+// SigsetDel removes the signal sig from set. It returns EINVAL if sig is
+// not a valid signal number.
+func SigsetDel(set *Sigset_t, sig syscall.Signal) error {
+	i, b, err := sigsetIndex(set, sig)
+	if err != nil {
+		return err
+	}
+	set.Val[i] &^= 1 << b
+	return nil
+}
+
+// SigsetIsMember reports whether the signal sig is in set. It returns
+// EINVAL if sig is not a valid signal number.
+func SigsetIsMember(set *Sigset_t, sig syscall.Signal) (bool, error) {
+	i, b, err := sigsetIndex(set, sig)
+	if err != nil {
+		return false, err
+	}
+	return set.Val[i]&(1<<b) != 0, nil
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestSigset(t *testing.T) {
+	var set unix.Sigset_t
+	for _, sig := range []unix.Signal{unix.SIGHUP, unix.SIGUSR1, unix.SIGSYS, 33, 64} {
+		if err := unix.SigsetAdd(&set, sig); err != nil {
+			t.Fatalf("SigsetAdd(%v): %v", sig, err)
+		}
+		if ok, err := unix.SigsetIsMember(&set, sig); err != nil || !ok {
+			t.Errorf("SigsetIsMember(%v) = %v, %v after SigsetAdd", sig, ok, err)
+		}
+	}
+	if ok, _ := unix.SigsetIsMember(&set, unix.SIGUSR2); ok {
+		t.Error("SigsetIsMember(SIGUSR2) = true for a signal never added")
+	}
+	if err := unix.SigsetDel(&set, unix.SIGUSR1); err != nil {
+		t.Fatalf("SigsetDel: %v", err)
+	}
+	if ok, _ := unix.SigsetIsMember(&set, unix.SIGUSR1); ok {
+		t.Error("SigsetIsMember(SIGUSR1) = true after SigsetDel")
+	}
+	if ok, _ := unix.SigsetIsMember(&set, unix.SIGHUP); !ok {
+		t.Error("SigsetDel(SIGUSR1) removed SIGHUP")
+	}
+	for _, sig := range []unix.Signal{0, -1, 1000} {
+		if err := unix.SigsetAdd(&set, sig); err != unix.EINVAL {
+			t.Errorf("SigsetAdd(%d): got %v, want EINVAL", sig, err)
+		}
+	}
+}
+
+// threadSigPending returns the set of signals pending for the calling
+// thread, as reported by /proc/thread-self/status.
+func threadSigPending() (uint64, error) {
+	b, err := os.ReadFile("/proc/thread-self/status")
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		if v, ok := strings.CutPrefix(line, "SigPnd:"); ok {
+			return strconv.ParseUint(strings.TrimSpace(v), 16, 64)
+		}
+	}
+	return 0, errors.New("no SigPnd in /proc/thread-self/status")
+}
+
+func TestPthreadSigmask(t *testing.T) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, unix.SIGUSR1)
+	defer signal.Stop(c)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// This thread's signal mask is modified, so never unlock it.
+		runtime.LockOSThread()
+
+		var set, old unix.Sigset_t
+		unix.SigsetAdd(&set, unix.SIGUSR1)
+		if err := unix.PthreadSigmask(unix.SIG_BLOCK, &set, &old); err != nil {
+			t.Errorf("PthreadSigmask(SIG_BLOCK): %v", err)
+			return
+		}
+		if ok, _ := unix.SigsetIsMember(&old, unix.SIGUSR1); ok {
+			t.Errorf("SIGUSR1 was blocked before SIG_BLOCK")
+		}
+		if err := unix.Tgkill(unix.Getpid(), unix.Gettid(), unix.SIGUSR1); err != nil {
+			t.Errorf("Tgkill: %v", err)
+			return
+		}
+		if pnd, err := threadSigPending(); err != nil {
+			t.Logf("cannot read pending signals: %v", err)
+		} else if pnd&(1<<(unix.SIGUSR1-1)) == 0 {
+			t.Errorf("SIGUSR1 not pending while blocked (SigPnd %#x)", pnd)
+		}
+		select {
+		case <-c:
+			t.Errorf("blocked SIGUSR1 was delivered")
+		default:
+		}
+
+		var cur unix.Sigset_t
+		if err := unix.PthreadSigmask(unix.SIG_UNBLOCK, &set, &cur); err != nil {
+			t.Errorf("PthreadSigmask(SIG_UNBLOCK): %v", err)
+			return
+		}
+		if ok, _ := unix.SigsetIsMember(&cur, unix.SIGUSR1); !ok {
+			t.Errorf("SIGUSR1 missing from the mask returned by SIG_UNBLOCK")
+		}
+	}()
+	<-done
+
+	select {
+	case <-c:
+	case <-time.After(10 * time.Second):
+		t.Error("SIGUSR1 not delivered after unblocking")
+	}
+}
+
+func TestSigtimedWait(t *testing.T) {
+	errc := make(chan error, 1)
+	go func() {
+		// This thread's signal mask is modified, so never unlock it.
+		runtime.LockOSThread()
+		errc <- func() error {
+			var set unix.Sigset_t
+			unix.SigsetAdd(&set, unix.SIGUSR1)
+			if err := unix.PthreadSigmask(unix.SIG_BLOCK, &set, nil); err != nil {
+				return fmt.Errorf("PthreadSigmask(SIG_BLOCK): %v", err)
+			}
+			defer unix.PthreadSigmask(unix.SIG_UNBLOCK, &set, nil)
+
+			ts := unix.NsecToTimespec(int64(10 * time.Millisecond))
+			if sig, err := unix.SigtimedWait(&set, nil, &ts); err != unix.EAGAIN {
+				return fmt.Errorf("SigtimedWait with nothing pending: got %v, %v; want EAGAIN", sig, err)
+			}
+
+			if err := unix.Tgkill(unix.Getpid(), unix.Gettid(), unix.SIGUSR1); err != nil {
+				return fmt.Errorf("Tgkill: %v", err)
+			}
+			var info unix.Siginfo
+			ts = unix.NsecToTimespec(int64(10 * time.Second))
+			sig, err := unix.SigtimedWait(&set, &info, &ts)
+			if err != nil {
+				return fmt.Errorf("SigtimedWait: %v", err)
+			}
+			if sig != unix.SIGUSR1 || info.Signo != int32(unix.SIGUSR1) {
+				return fmt.Errorf("SigtimedWait = %v (si_signo %d), want SIGUSR1", sig, info.Signo)
+			}
+			if info.Code != unix.SI_TKILL {
+				return fmt.Errorf("si_code = %d, want SI_TKILL (%d)", info.Code, unix.SI_TKILL)
+			}
+			return nil
+		}()
+	}()
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRttgsigqueueinfo(t *testing.T) {
+	const payload = 0x5eed
+	errc := make(chan error, 1)
+	go func() {
+		// This thread's signal mask is modified, so never unlock it.
+		runtime.LockOSThread()
+		errc <- func() error {
+			var set unix.Sigset_t
+			unix.SigsetAdd(&set, unix.SIGUSR1)
+			if err := unix.PthreadSigmask(unix.SIG_BLOCK, &set, nil); err != nil {
+				return fmt.Errorf("PthreadSigmask(SIG_BLOCK): %v", err)
+			}
+			defer unix.PthreadSigmask(unix.SIG_UNBLOCK, &set, nil)
+
+			fd, err := unix.Signalfd(-1, &set, unix.SFD_CLOEXEC)
+			if err != nil {
+				return fmt.Errorf("Signalfd: %v", err)
+			}
+			defer unix.Close(fd)
+
+			info := unix.Siginfo{Signo: int32(unix.SIGUSR1), Code: unix.SI_QUEUE}
+			info.SetValue(payload)
+			if v := info.Value(); v != payload {
+				return fmt.Errorf("Value after SetValue = %#x, want %#x", v, payload)
+			}
+			if err := unix.Rttgsigqueueinfo(unix.Getpid(), unix.Gettid(), unix.SIGUSR1, &info); err != nil {
+				return fmt.Errorf("Rttgsigqueueinfo: %v", err)
+			}
+
+			var ssi unix.SignalfdSiginfo
+			buf := unsafe.Slice((*byte)(unsafe.Pointer(&ssi)), unsafe.Sizeof(ssi))
+			if n, err := unix.Read(fd, buf); err != nil || n != len(buf) {
+				return fmt.Errorf("Read(signalfd) = %d, %v", n, err)
+			}
+			if ssi.Signo != uint32(unix.SIGUSR1) || ssi.Code != unix.SI_QUEUE {
+				return fmt.Errorf("got signal %d with code %d, want SIGUSR1 with SI_QUEUE", ssi.Signo, ssi.Code)
+			}
+			if ssi.Ptr != payload {
+				return fmt.Errorf("ssi_ptr = %#x, want %#x", ssi.Ptr, payload)
+			}
+			return nil
+		}()
+	}()
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRtsigqueueinfo(t *testing.T) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, unix.SIGUSR1)
+	defer signal.Stop(c)
+
+	info := unix.Siginfo{Signo: int32(unix.SIGUSR1), Code: unix.SI_QUEUE}
+	info.SetValue(1)
+	if err := unix.Rtsigqueueinfo(unix.Getpid(), unix.SIGUSR1, &info); err != nil {
+		t.Fatalf("Rtsigqueueinfo: %v", err)
+	}
+	select {
+	case <-c:
+	case <-time.After(10 * time.Second):
+		t.Fatal("queued SIGUSR1 not delivered")
+	}
+
+	// Signals queued to another process may not forge a kill-style si_code.
+	// The kernel rejects them before any permission check or delivery.
+	if unix.Getpid() != 1 {
+		info.Code = unix.SI_USER
+		if err := unix.Rtsigqueueinfo(1, unix.SIGUSR1, &info); err != unix.EPERM {
+			t.Errorf("Rtsigqueueinfo(1, SI_USER): got %v, want EPERM", err)
+		}
+	}
+}
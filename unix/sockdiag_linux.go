@@ -0,0 +1,106 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Socket enumeration with the sock_diag netlink interface, see sock_diag(7).
+
+package unix
+
+import (
+	"os"
+	"strconv"
+	"unsafe"
+)
+
+// SockDiagEntry describes a socket reported by SockDiagTCP.
+type SockDiagEntry struct {
+	State      int      // one of the TCP_* connection states
+	LocalAddr  Sockaddr // *SockaddrInet4 or *SockaddrInet6
+	RemoteAddr Sockaddr // *SockaddrInet4 or *SockaddrInet6
+	Inode      uint64   // inode number of the socket, 0 for time-wait sockets
+	UID        uint32   // user ID of the socket owner
+}
+
+// SockDiagTCP returns the TCP sockets of the given family, AF_INET or
+// AF_INET6, in all states, in the calling thread's network namespace. It
+// sends an inet_diag_req_v2 dump request with NETLINK_SOCK_DIAG.
+func SockDiagTCP(family int) ([]SockDiagEntry, error) {
+	if family != AF_INET && family != AF_INET6 {
+		return nil, EAFNOSUPPORT
+	}
+	req := InetDiagReqV2{
+		Sdiag_family:   uint8(family),
+		Sdiag_protocol: IPPROTO_TCP,
+		Idiag_states:   ^uint32(0),
+	}
+	msgs, err := netlinkRequest(NETLINK_SOCK_DIAG, SOCK_DIAG_BY_FAMILY, NLM_F_DUMP,
+		(*[SizeofInetDiagReqV2]byte)(unsafe.Pointer(&req))[:])
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]SockDiagEntry, 0, len(msgs))
+	for _, b := range msgs {
+		if len(b) < SizeofInetDiagMsg {
+			return nil, EINVAL
+		}
+		m := (*InetDiagMsg)(unsafe.Pointer(&b[0]))
+		entries = append(entries, SockDiagEntry{
+			State:      int(m.State),
+			LocalAddr:  inetDiagSockaddr(int(m.Family), &m.Id.Src, m.Id.Sport),
+			RemoteAddr: inetDiagSockaddr(int(m.Family), &m.Id.Dst, m.Id.Dport),
+			Inode:      uint64(m.Inode),
+			UID:        m.Uid,
+		})
+	}
+	return entries, nil
+}
+
+// inetDiagSockaddr converts an address and port of an InetDiagSockID, both
+// in network byte order, to a Sockaddr.
+func inetDiagSockaddr(family int, addr *[4]uint32, port uint16) Sockaddr {
+	a := (*[16]byte)(unsafe.Pointer(addr))
+	p := (*[2]byte)(unsafe.Pointer(&port))
+	if family == AF_INET6 {
+		sa := &SockaddrInet6{Port: int(p[0])<<8 | int(p[1])}
+		copy(sa.Addr[:], a[:])
+		return sa
+	}
+	sa := &SockaddrInet4{Port: int(p[0])<<8 | int(p[1])}
+	copy(sa.Addr[:], a[:])
+	return sa
+}
+
+// SocketOwner returns the ID of a process holding a file descriptor for the
+// socket with the given inode number, as reported by SockDiagTCP or by
+// Fstat of the socket. It scans the /proc/<pid>/fd directories, so it only
+// sees processes whose file descriptors the caller is allowed to read:
+// normally those of the same user, or all of them with CAP_SYS_PTRACE. If
+// several processes share the socket, any one of them is returned. ENOENT
+// is returned if no process is found.
+func SocketOwner(inode uint64) (pid int, err error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return 0, err
+	}
+	target := "socket:[" + strconv.FormatUint(inode, 10) + "]"
+	buf := make([]byte, len(target)+1)
+	for _, e := range entries {
+		pid, err := strconv.Atoi(e.Name())
+		if err != nil {
+			continue
+		}
+		dir := "/proc/" + e.Name() + "/fd/"
+		fds, err := os.ReadDir(dir)
+		if err != nil {
+			// The process exited or its descriptors are not readable.
+			continue
+		}
+		for _, fd := range fds {
+			n, err := Readlink(dir+fd.Name(), buf)
+			if err == nil && string(buf[:n]) == target {
+				return pid, nil
+			}
+		}
+	}
+	return 0, ENOENT
+}
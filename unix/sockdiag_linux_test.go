@@ -0,0 +1,105 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// tcpListener returns a TCP socket listening on an ephemeral loopback port
+// and its port number. It is closed when t finishes.
+func tcpListener(t *testing.T) (fd, port int) {
+	t.Helper()
+	fd = tcpSocket(t)
+	if err := unix.Bind(fd, &unix.SockaddrInet4{Addr: [4]byte{127, 0, 0, 1}}); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+	if err := unix.Listen(fd, 1); err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	sa, err := unix.Getsockname(fd)
+	if err != nil {
+		t.Fatalf("Getsockname: %v", err)
+	}
+	return fd, sa.(*unix.SockaddrInet4).Port
+}
+
+func TestSockDiagTCP(t *testing.T) {
+	if _, err := unix.SockDiagTCP(unix.AF_UNIX); err != unix.EAFNOSUPPORT {
+		t.Errorf("SockDiagTCP(AF_UNIX): got %v, want EAFNOSUPPORT", err)
+	}
+
+	fd, port := tcpListener(t)
+	var st unix.Stat_t
+	if err := unix.Fstat(fd, &st); err != nil {
+		t.Fatalf("Fstat: %v", err)
+	}
+
+	entries, err := unix.SockDiagTCP(unix.AF_INET)
+	if err != nil {
+		t.Fatalf("SockDiagTCP: %v", err)
+	}
+	for _, e := range entries {
+		local, ok := e.LocalAddr.(*unix.SockaddrInet4)
+		if !ok {
+			t.Fatalf("LocalAddr = %T, want *unix.SockaddrInet4", e.LocalAddr)
+		}
+		if local.Port != port {
+			continue
+		}
+		if e.State != unix.TCP_LISTEN {
+			t.Errorf("State = %d, want TCP_LISTEN", e.State)
+		}
+		if local.Addr != [4]byte{127, 0, 0, 1} {
+			t.Errorf("LocalAddr = %v, want 127.0.0.1", local.Addr)
+		}
+		if e.Inode != st.Ino {
+			t.Errorf("Inode = %d, want %d", e.Inode, st.Ino)
+		}
+		if e.UID != uint32(unix.Geteuid()) {
+			t.Errorf("UID = %d, want %d", e.UID, unix.Geteuid())
+		}
+		return
+	}
+	t.Errorf("listening socket on port %d not found in %d entries", port, len(entries))
+}
+
+func TestSocketOwner(t *testing.T) {
+	fd, port := tcpListener(t)
+	entries, err := unix.SockDiagTCP(unix.AF_INET)
+	if err != nil {
+		t.Fatalf("SockDiagTCP: %v", err)
+	}
+	var inode uint64
+	for _, e := range entries {
+		if e.LocalAddr.(*unix.SockaddrInet4).Port == port {
+			inode = e.Inode
+		}
+	}
+	var st unix.Stat_t
+	if err := unix.Fstat(fd, &st); err != nil {
+		t.Fatalf("Fstat: %v", err)
+	}
+	if inode != st.Ino {
+		t.Fatalf("SockDiagTCP inode = %d, Fstat inode = %d", inode, st.Ino)
+	}
+
+	pid, err := unix.SocketOwner(inode)
+	if err != nil {
+		t.Fatalf("SocketOwner: %v", err)
+	}
+	if pid != unix.Getpid() {
+		t.Errorf("SocketOwner = %d, want %d", pid, unix.Getpid())
+	}
+
+	// Socket inode numbers start at 1.
+	if pid, err := unix.SocketOwner(0); err != unix.ENOENT {
+		t.Errorf("SocketOwner(0) = %d, %v; want ENOENT", pid, err)
+	}
+}
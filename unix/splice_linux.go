@@ -0,0 +1,141 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import (
+	"io"
+	"os"
+	"syscall"
+)
+
+// maxSpliceSize is the maximum number of bytes moved by a single splice
+// call in CopyFileToConn.
+const maxSpliceSize = 1 << 20
+
+// CopyFileToConn copies count bytes from file, starting at its current
+// offset, to conn. It returns the number of bytes copied and the first error
+// encountered, if any. As with io.CopyN, the returned error is io.EOF if
+// file ends before count bytes have been copied.
+//
+// If file is a regular file or block device and conn is a socket, the data
+// is moved with splice(2) through an intermediate pipe and never enters user
+// space. This is the same technique net/http uses internally. Otherwise the
+// copy falls back to io.CopyN.
+func CopyFileToConn(conn syscall.Conn, file *os.File, count int64) (int64, error) {
+	dst, err := conn.SyscallConn()
+	if err != nil {
+		return 0, err
+	}
+	src, err := file.SyscallConn()
+	if err != nil {
+		return 0, err
+	}
+	if canSplice(src, S_IFREG, S_IFBLK) && canSplice(dst, S_IFSOCK) {
+		written, handled, err := spliceConn(dst, src, count)
+		if handled {
+			return written, err
+		}
+	}
+	w, ok := conn.(io.Writer)
+	if !ok {
+		w = rawConnWriter{dst}
+	}
+	return io.CopyN(w, file, count)
+}
+
+// canSplice reports whether the file referred to by c has one of the given
+// file types.
+func canSplice(c syscall.RawConn, types ...uint32) bool {
+	var st Stat_t
+	var serr error
+	if err := c.Control(func(fd uintptr) {
+		serr = Fstat(int(fd), &st)
+	}); err != nil || serr != nil {
+		return false
+	}
+	for _, typ := range types {
+		if st.Mode&S_IFMT == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// spliceConn moves up to count bytes from src to dst through a pipe. handled
+// is false if splice turned out to be unsupported before any data was moved,
+// in which case the caller should fall back to an ordinary copy.
+func spliceConn(dst, src syscall.RawConn, count int64) (written int64, handled bool, err error) {
+	var p [2]int
+	if err := Pipe2(p[:], O_CLOEXEC); err != nil {
+		return 0, false, nil
+	}
+	defer Close(p[0])
+	defer Close(p[1])
+
+	for written < count {
+		chunk := count - written
+		if chunk > maxSpliceSize {
+			chunk = maxSpliceSize
+		}
+
+		var inPipe int64
+		var serr error
+		if err := src.Control(func(fd uintptr) {
+			n, err := Splice(int(fd), nil, p[1], nil, int(chunk), SPLICE_F_MOVE|SPLICE_F_NONBLOCK)
+			inPipe, serr = int64(n), err
+		}); err != nil {
+			return written, true, err
+		}
+		if serr != nil {
+			if written == 0 && (serr == EINVAL || serr == ENOSYS) {
+				return 0, false, nil
+			}
+			return written, true, serr
+		}
+		if inPipe == 0 {
+			return written, true, io.EOF
+		}
+
+		for inPipe > 0 {
+			var n int64
+			if err := dst.Write(func(fd uintptr) bool {
+				m, err := Splice(p[0], nil, int(fd), nil, int(inPipe), SPLICE_F_MOVE|SPLICE_F_NONBLOCK)
+				n, serr = int64(m), err
+				return serr != EAGAIN
+			}); err != nil {
+				return written, true, err
+			}
+			if serr != nil {
+				return written, true, serr
+			}
+			inPipe -= n
+			written += n
+		}
+	}
+	return written, true, nil
+}
+
+// rawConnWriter adapts a syscall.RawConn to an io.Writer.
+type rawConnWriter struct {
+	c syscall.RawConn
+}
+
+func (w rawConnWriter) Write(p []byte) (n int, err error) {
+	for n < len(p) {
+		var m int
+		var werr error
+		if err := w.c.Write(func(fd uintptr) bool {
+			m, werr = Write(int(fd), p[n:])
+			return werr != EAGAIN
+		}); err != nil {
+			return n, err
+		}
+		if werr != nil {
+			return n, werr
+		}
+		n += m
+	}
+	return n, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestCopyFileToConn(t *testing.T) {
+	want := make([]byte, 3<<20+123)
+	rand.New(rand.NewSource(1)).Read(want)
+	name := filepath.Join(t.TempDir(), "data")
+	if err := os.WriteFile(name, want, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("TCP", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+
+		client, err := net.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+		server, err := ln.Accept()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := make(chan []byte, 1)
+		go func() {
+			b, _ := io.ReadAll(client)
+			got <- b
+		}()
+
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		n, err := unix.CopyFileToConn(server.(*net.TCPConn), f, int64(len(want)))
+		server.Close()
+		if err != nil {
+			t.Fatalf("CopyFileToConn: %v", err)
+		}
+		if n != int64(len(want)) {
+			t.Errorf("CopyFileToConn: copied %d bytes, want %d", n, len(want))
+		}
+		if b := <-got; !bytes.Equal(b, want) {
+			t.Errorf("received %d bytes that differ from the %d bytes sent", len(b), len(want))
+		}
+	})
+
+	t.Run("Fallback", func(t *testing.T) {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+
+		got := make(chan []byte, 1)
+		go func() {
+			b, _ := io.ReadAll(r)
+			got <- b
+		}()
+
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		const count = 4096
+		n, err := unix.CopyFileToConn(w, f, count)
+		w.Close()
+		if err != nil {
+			t.Fatalf("CopyFileToConn: %v", err)
+		}
+		if n != count {
+			t.Errorf("CopyFileToConn: copied %d bytes, want %d", n, count)
+		}
+		if b := <-got; !bytes.Equal(b, want[:count]) {
+			t.Errorf("received %d bytes that differ from the %d bytes sent", len(b), count)
+		}
+	})
+
+	t.Run("EOF", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+
+		client, err := net.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+		server, err := ln.Accept()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer server.Close()
+		go io.Copy(io.Discard, client)
+
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		n, err := unix.CopyFileToConn(server.(*net.TCPConn), f, int64(len(want))+1)
+		if err != io.EOF {
+			t.Errorf("CopyFileToConn past end of file: got error %v, want %v", err, io.EOF)
+		}
+		if n != int64(len(want)) {
+			t.Errorf("CopyFileToConn: copied %d bytes, want %d", n, len(want))
+		}
+	})
+}
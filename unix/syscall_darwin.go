@@ -14,6 +14,7 @@ package unix
 
 import (
 	"fmt"
+	"strconv"
 	"syscall"
 	"unsafe"
 )
@@ -416,6 +417,14 @@ func RenameatxNp(fromfd int, from string, tofd int, to string, flag uint32) (err
 
 //sys	sysctl(mib []_C_int, old *byte, oldlen *uintptr, new *byte, newlen uintptr) (err error) = SYS_SYSCTL
 
+// Fexecve executes the program referred to by the file descriptor fd.
+// Darwin has no fexecve system call, so the program is executed through
+// its /dev/fd entry, which must be able to reopen fd for reading. The argv
+// and envv arguments are as for Exec. Fexecve only returns on failure.
+func Fexecve(fd int, argv, envv []string) error {
+	return Exec("/dev/fd/"+strconv.Itoa(fd), argv, envv)
+}
+
 func Uname(uname *Utsname) error {
 	mib := []_C_int{CTL_KERN, KERN_OSTYPE}
 	n := unsafe.Sizeof(uname.Sysname)
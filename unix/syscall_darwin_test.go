@@ -6,6 +6,7 @@ package unix_test
 
 import (
 	"bytes"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
@@ -227,6 +228,50 @@ func TestGetsockoptXucred(t *testing.T) {
 	}
 }
 
+func TestConnectx(t *testing.T) {
+	ln, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	port := ln.Addr().(*net.TCPAddr).Port
+
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_STREAM, 0)
+	if err != nil {
+		t.Fatalf("Socket: %v", err)
+	}
+	defer unix.Close(fd)
+
+	data := []byte("idempotent hello")
+	iov := []unix.Iovec{{Base: &data[0]}}
+	iov[0].SetLen(len(data))
+	dst := &unix.SockaddrInet4{Port: port, Addr: [4]byte{127, 0, 0, 1}}
+	n, err := unix.Connectx(fd, 0, nil, dst, unix.SAE_ASSOCID_ANY, unix.CONNECT_DATA_IDEMPOTENT, iov, nil)
+	if err != nil {
+		t.Fatalf("Connectx: %v", err)
+	}
+	t.Logf("Connectx enqueued %d bytes", n)
+	if int(n) < len(data) {
+		// Without a TFO cookie the data may not be sent with the SYN.
+		if _, err := unix.Write(fd, data[n:]); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	got := make([]byte, len(data))
+	if _, err := io.ReadFull(conn, got); err != nil {
+		t.Fatalf("ReadFull: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("received %q, want %q", got, data)
+	}
+}
+
 func TestSysctlKinfoProc(t *testing.T) {
 	pid := unix.Getpid()
 	kp, err := unix.SysctlKinfoProc("kern.proc.pid", pid)
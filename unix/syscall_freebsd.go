@@ -167,6 +167,34 @@ func Getfsstat(buf []Statfs_t, flags int) (n int, err error) {
 
 //sys	sysctl(mib []_C_int, old *byte, oldlen *uintptr, new *byte, newlen uintptr) (err error) = SYS___SYSCTL
 
+//sys	ppoll(fds *PollFd, nfds int, timeout *Timespec, sigmask *Sigset_t) (n int, err error)
+
+func Ppoll(fds []PollFd, timeout *Timespec, sigmask *Sigset_t) (n int, err error) {
+	if len(fds) == 0 {
+		return ppoll(nil, 0, timeout, sigmask)
+	}
+	return ppoll(&fds[0], len(fds), timeout, sigmask)
+}
+
+//sys	Pselect(nfd int, r *FdSet, w *FdSet, e *FdSet, timeout *Timespec, sigmask *Sigset_t) (n int, err error)
+
+//sysnb	fexecve(fd int, argv **byte, envv **byte) (err error)
+
+// Fexecve executes the program referred to by the file descriptor fd,
+// which must have been opened for execution or reading. The argv and envv
+// arguments are as for Exec. Fexecve only returns on failure.
+func Fexecve(fd int, argv, envv []string) error {
+	argvp, envvp, err := execArgs(argv, envv)
+	if err != nil {
+		return err
+	}
+	restoreRlimitNofile()
+	runtime_BeforeExec()
+	err = fexecve(fd, &argvp[0], &envvp[0])
+	runtime_AfterExec()
+	return err
+}
+
 func Uname(uname *Utsname) error {
 	mib := []_C_int{CTL_KERN, KERN_OSTYPE}
 	n := unsafe.Sizeof(uname.Sysname)
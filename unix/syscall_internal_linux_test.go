@@ -949,3 +949,20 @@ func sockaddrVMToAny(in RawSockaddrVM) *RawSockaddrAny {
 	)
 	return &out
 }
+
+func TestSyscallSupportedCached(t *testing.T) {
+	orig := syscallProbe
+	defer func() { syscallProbe = orig }()
+	var calls int
+	syscallProbe = func(trap uintptr, args [6]uintptr) Errno {
+		calls++
+		return orig(trap, args)
+	}
+	syscallSupported.Delete(uintptr(SYS_STATX))
+	for i := 0; i < 3; i++ {
+		SyscallSupported(SYS_STATX)
+	}
+	if calls != 1 {
+		t.Errorf("SyscallSupported(SYS_STATX) three times made %d system calls, want 1", calls)
+	}
+}
@@ -125,6 +125,12 @@ func Openat(dirfd int, path string, flags int, mode uint32) (fd int, err error)
 
 //sys	openat2(dirfd int, path string, open_how *OpenHow, size int) (fd int, err error)
 
+// Openat2 opens path relative to dirfd like Openat, with the O_* flags and
+// mode in how.Flags and how.Mode, and restricts the resolution of path with
+// the RESOLVE_* flags in how.Resolve. With RESOLVE_BENEATH, resolution
+// fails with EXDEV if it would leave dirfd, and with RESOLVE_IN_ROOT it is
+// instead confined to dirfd as if it were the root directory, which makes
+// it safe to open untrusted paths below dirfd. It requires Linux 5.6.
 func Openat2(dirfd int, path string, how *OpenHow) (fd int, err error) {
 	return openat2(dirfd, path, how, SizeofOpenHow)
 }
@@ -166,6 +172,27 @@ func Poll(fds []PollFd, timeout int) (n int, err error) {
 	return Ppoll(fds, ts, nil)
 }
 
+// kernelTimespec is the kernel's struct __kernel_timespec, which has 64-bit
+// fields on every architecture, unlike Timespec on 32-bit systems.
+type kernelTimespec struct {
+	Sec  int64
+	Nsec int64
+}
+
+//sys	epollPwait2(epfd int, events []EpollEvent, timeout *kernelTimespec, sigmask *Sigset_t, sigsetsize uintptr) (n int, err error) = SYS_EPOLL_PWAIT2
+
+// EpollPwait2 is like EpollWait but takes a timeout with nanosecond
+// precision and, if sigmask is non-nil, atomically replaces the thread's
+// signal mask for the duration of the wait. A nil timeout blocks
+// indefinitely. It requires Linux 5.11 or later.
+func EpollPwait2(epfd int, events []EpollEvent, timeout *Timespec, sigmask *Sigset_t) (n int, err error) {
+	var ts *kernelTimespec
+	if timeout != nil {
+		ts = &kernelTimespec{Sec: int64(timeout.Sec), Nsec: int64(timeout.Nsec)}
+	}
+	return epollPwait2(epfd, events, ts, sigmask, _C__NSIG/8)
+}
+
 //sys	Readlinkat(dirfd int, path string, buf []byte) (n int, err error)
 
 func Readlink(path string, buf []byte) (n int, err error) {
@@ -377,6 +404,58 @@ func Wait4(pid int, wstatus *WaitStatus, options int, rusage *Rusage) (wpid int,
 
 //sys	Waitid(idType int, id int, info *Siginfo, options int, rusage *Rusage) (err error)
 
+// SiginfoChild describes a change of state of a child process, as reported
+// by WaitidChild or by the Siginfo of a SIGCHLD signal.
+type SiginfoChild struct {
+	Pid    int // process ID of the child
+	Uid    int // real user ID of the child
+	Code   int // one of the CLD_* constants
+	Status int // exit status with CLD_EXITED, signal number otherwise
+}
+
+// siginfoFieldsOffset is the offset in a Siginfo of the union of the fields
+// specific to each signal, which follows three ints and is aligned like a
+// pointer.
+const siginfoFieldsOffset = (3*4 + unsafe.Sizeof(uintptr(0)) - 1) &^ (unsafe.Sizeof(uintptr(0)) - 1)
+
+// Child returns the child process fields of a Siginfo filled in by Waitid
+// or delivered with SIGCHLD.
+func (info *Siginfo) Child() SiginfoChild {
+	f := (*[3]int32)(unsafe.Add(unsafe.Pointer(info), siginfoFieldsOffset))
+	return SiginfoChild{
+		Pid:    int(f[0]),
+		Uid:    int(uint32(f[1])),
+		Code:   int(info.Code),
+		Status: int(f[2]),
+	}
+}
+
+// Value returns the si_value payload of a Siginfo for a signal queued with
+// SI_QUEUE, such as by Rtsigqueueinfo, or for a timer or message queue
+// notification.
+func (info *Siginfo) Value() uintptr {
+	return *(*uintptr)(unsafe.Add(unsafe.Pointer(info), siginfoFieldsOffset+8))
+}
+
+// SetValue sets the si_value payload of a Siginfo to be queued with
+// Rtsigqueueinfo or Rttgsigqueueinfo and Code SI_QUEUE.
+func (info *Siginfo) SetValue(v uintptr) {
+	*(*uintptr)(unsafe.Add(unsafe.Pointer(info), siginfoFieldsOffset+8)) = v
+}
+
+// WaitidChild is like Waitid, but returns the change of state of the child
+// as a SiginfoChild. With idType P_PIDFD, id is a pidfd as returned by
+// PidfdOpen, which waits for that process without the risk of its process
+// ID having been reused. If options contains WNOHANG and no child has
+// changed state, the returned Pid is 0.
+func WaitidChild(idType int, id int, options int, rusage *Rusage) (SiginfoChild, error) {
+	var info Siginfo
+	if err := Waitid(idType, id, &info, options, rusage); err != nil {
+		return SiginfoChild{}, err
+	}
+	return info.Child(), nil
+}
+
 func Mkfifo(path string, mode uint32) error {
 	return Mknod(path, mode|S_IFIFO, 0)
 }
@@ -1295,6 +1374,17 @@ func GetsockoptTCPInfo(fd, level, opt int) (*TCPInfo, error) {
 	return &value, err
 }
 
+// GetsockoptMemInfo returns the memory usage of the socket fd, read with
+// SO_MEMINFO. The fields are all in bytes except Drops, which counts the
+// packets dropped by the socket. Fields unknown to the running kernel are
+// left zero.
+func GetsockoptMemInfo(fd int) (*SockMemInfo, error) {
+	var value SockMemInfo
+	vallen := _Socklen(SizeofSockMemInfo)
+	err := getsockopt(fd, SOL_SOCKET, SO_MEMINFO, unsafe.Pointer(&value), &vallen)
+	return &value, err
+}
+
 // GetsockoptTCPCCVegasInfo returns algorithm specific congestion control information for a socket using the "vegas"
 // algorithm.
 //
@@ -1383,6 +1473,34 @@ func SetsockoptSockFprog(fd, level, opt int, fprog *SockFprog) error {
 	return setsockopt(fd, level, opt, unsafe.Pointer(fprog), unsafe.Sizeof(*fprog))
 }
 
+// SetsockoptAttachFilter attaches the classic BPF program prog to the socket
+// fd with SO_ATTACH_FILTER, replacing any filter already attached.
+func SetsockoptAttachFilter(fd int, prog *SockFprog) error {
+	return SetsockoptSockFprog(fd, SOL_SOCKET, SO_ATTACH_FILTER, prog)
+}
+
+// SetsockoptDetachFilter removes the filter attached to the socket fd with
+// SO_DETACH_FILTER. It returns ENOENT if no filter is attached.
+func SetsockoptDetachFilter(fd int) error {
+	return SetsockoptInt(fd, SOL_SOCKET, SO_DETACH_FILTER, 0)
+}
+
+// SetsockoptSelectErrQueue sets SO_SELECT_ERR_QUEUE on the socket fd. While
+// it is on, pending messages on the socket error queue, such as transmit
+// timestamps, are reported as an exceptional condition by Select and as
+// POLLPRI by Poll in addition to the POLLERR that is always reported.
+func SetsockoptSelectErrQueue(fd int, on bool) error {
+	return setsockoptBool(fd, SOL_SOCKET, SO_SELECT_ERR_QUEUE, on)
+}
+
+// GetSocketCookie returns the cookie of the socket fd, read with SO_COOKIE
+// (Linux >= 4.12). The cookie is a 64-bit identifier assigned by the kernel
+// that is unique for the lifetime of the system, and is the value reported
+// by the bpf_get_socket_cookie helper and by sock_diag.
+func GetSocketCookie(fd int) (uint64, error) {
+	return GetsockoptUint64(fd, SOL_SOCKET, SO_COOKIE)
+}
+
 func SetsockoptCanRawFilter(fd, level, opt int, filter []CanFilter) error {
 	var p unsafe.Pointer
 	if len(filter) > 0 {
@@ -1826,6 +1944,8 @@ func Mount(source string, target string, fstype string, flags uintptr, data stri
 	return mount(source, target, fstype, flags, datap)
 }
 
+//sys	statmount(req *MntIdReq, buf []byte, flags uint) (err error) = SYS_STATMOUNT
+//sys	listmount(req *MntIdReq, ids []uint64, flags uint) (n int, err error) = SYS_LISTMOUNT
 //sys	mountSetattr(dirfd int, pathname string, flags uint, attr *MountAttr, size uintptr) (err error) = SYS_MOUNT_SETATTR
 
 // MountSetattr is a wrapper for mount_setattr(2).
@@ -1853,6 +1973,7 @@ func Sendfile(outfd int, infd int, offset *int64, count int) (written int, err e
 //sys	Acct(path string) (err error)
 //sys	AddKey(keyType string, description string, payload []byte, ringid int) (id int, err error)
 //sys	Adjtimex(buf *Timex) (state int, err error)
+//sys	bpf(cmd int, attr unsafe.Pointer, size uintptr) (ret int, err error) = SYS_BPF
 //sysnb	Capget(hdr *CapUserHeader, data *CapUserData) (err error)
 //sysnb	Capset(hdr *CapUserHeader, data *CapUserData) (err error)
 //sys	Chdir(path string) (err error)
@@ -2030,15 +2151,29 @@ func Getrandom(buf []byte, flags int) (n int, err error) {
 //sys	InotifyAddWatch(fd int, pathname string, mask uint32) (watchdesc int, err error)
 //sysnb	InotifyInit1(flags int) (fd int, err error)
 //sysnb	InotifyRmWatch(fd int, watchdesc uint32) (success int, err error)
+//sys	IoUringSetup(entries uint32, params *IoUringParams) (fd int, err error) = SYS_IO_URING_SETUP
+//sys	ioUringEnter(fd int, toSubmit uint32, minComplete uint32, flags uint32, sigmask *Sigset_t, sigsetsize uintptr) (n int, err error) = SYS_IO_URING_ENTER
+//sys	IoUringRegister(fd int, opcode uint32, arg unsafe.Pointer, nrArgs uint32) (ret int, err error) = SYS_IO_URING_REGISTER
 //sysnb	Kill(pid int, sig syscall.Signal) (err error)
 //sys	Klogctl(typ int, buf []byte) (n int, err error) = SYS_SYSLOG
+//sys	LandlockCreateRuleset(attr *LandlockRulesetAttr, size uintptr, flags uint32) (fd int, err error) = SYS_LANDLOCK_CREATE_RULESET
+//sys	LandlockAddRule(rulesetFd int, ruleType int, ruleAttr unsafe.Pointer, flags uint32) (err error) = SYS_LANDLOCK_ADD_RULE
+//sys	LandlockRestrictSelf(rulesetFd int, flags uint32) (err error) = SYS_LANDLOCK_RESTRICT_SELF
 //sys	Lgetxattr(path string, attr string, dest []byte) (sz int, err error)
 //sys	Listxattr(path string, dest []byte) (sz int, err error)
 //sys	Llistxattr(path string, dest []byte) (sz int, err error)
 //sys	Lremovexattr(path string, attr string) (err error)
 //sys	Lsetxattr(path string, attr string, data []byte, flags int) (err error)
 //sys	MemfdCreate(name string, flags int) (fd int, err error)
+
+// Mkdirat creates the directory path relative to dirfd. Its permission
+// bits are mode&^umask, where umask is the process file mode creation mask
+// set with Umask, or are derived from mode and the default ACL of the
+// parent directory if it has one. The S_ISVTX bit of mode is kept and the
+// S_ISGID bit is inherited from the parent. Use MkdiratMode to get exactly
+// the permission bits in mode.
 //sys	Mkdirat(dirfd int, path string, mode uint32) (err error)
+
 //sys	Mknodat(dirfd int, path string, mode uint32, dev int) (err error)
 //sys	MoveMount(fromDirfd int, fromPathName string, toDirfd int, toPathName string, flags int) (err error)
 //sys	Nanosleep(time *Timespec, leftover *Timespec) (err error)
@@ -2051,6 +2186,7 @@ func Getrandom(buf []byte, flags int) (n int, err error) {
 //sys	Removexattr(path string, attr string) (err error)
 //sys	Renameat2(olddirfd int, oldpath string, newdirfd int, newpath string, flags uint) (err error)
 //sys	RequestKey(keyType string, description string, callback string, destRingid int) (id int, err error)
+//sys	seccomp(op uint, flags uint, args unsafe.Pointer) (ret int, err error) = SYS_SECCOMP
 //sys	Setdomainname(p []byte) (err error)
 //sys	Sethostname(p []byte) (err error)
 //sysnb	Setpgid(pid int, pgid int) (err error)
@@ -2067,6 +2203,35 @@ func Prlimit(pid, resource int, newlimit, old *Rlimit) error {
 	return syscall_prlimit(pid, resource, (*syscall.Rlimit)(newlimit), (*syscall.Rlimit)(old))
 }
 
+//sysnb	execveat(dirfd int, path *byte, argv **byte, envv **byte, flags int) (err error) = SYS_EXECVEAT
+
+// Execveat is like Exec but executes the program at path relative to the
+// directory dirfd, as for Openat. With AT_EMPTY_PATH and an empty path it
+// executes the file referred to by dirfd itself, as fexecve(3) does. With
+// AT_SYMLINK_NOFOLLOW it fails with ELOOP if path is a symbolic link.
+// Execveat only returns on failure.
+func Execveat(dirfd int, path string, argv, envv []string, flags int) error {
+	pathp, err := BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+	argvp, envvp, err := execArgs(argv, envv)
+	if err != nil {
+		return err
+	}
+	restoreRlimitNofile()
+	runtime_BeforeExec()
+	err = execveat(dirfd, pathp, &argvp[0], &envvp[0], flags)
+	runtime_AfterExec()
+	return err
+}
+
+// Fexecve executes the program referred to by the file descriptor fd, as
+// Execveat with AT_EMPTY_PATH does. Fexecve only returns on failure.
+func Fexecve(fd int, argv, envv []string) error {
+	return Execveat(fd, "", argv, envv, AT_EMPTY_PATH)
+}
+
 // PrctlRetInt performs a prctl operation specified by option and further
 // optional arguments arg2 through arg5 depending on option. It returns a
 // non-negative integer that is returned by the prctl syscall.
@@ -2147,6 +2312,7 @@ func Signalfd(fd int, sigmask *Sigset_t, flags int) (newfd int, err error) {
 //sysnb	Uname(buf *Utsname) (err error)
 //sys	Unmount(target string, flags int) (err error) = SYS_UMOUNT2
 //sys	Unshare(flags int) (err error)
+//sys	Userfaultfd(flags int) (fd int, err error)
 //sys	write(fd int, p []byte) (n int, err error)
 //sys	exitThread(code int) (err error) = SYS_EXIT
 //sys	readv(fd int, iovs []Iovec) (n int, err error) = SYS_READV
@@ -2506,9 +2672,9 @@ func Klogset(typ int, arg int) (err error) {
 }
 
 // RemoteIovec is Iovec with the pointer replaced with an integer.
-// It is used for ProcessVMReadv and ProcessVMWritev, where the pointer
-// refers to a location in a different process' address space, which
-// would confuse the Go garbage collector.
+// It is used for ProcessVMReadv, ProcessVMWritev and ProcessMadvise,
+// where the pointer refers to a location in a different process' address
+// space, which would confuse the Go garbage collector.
 type RemoteIovec struct {
 	Base uintptr
 	Len  int
@@ -2520,6 +2686,8 @@ type RemoteIovec struct {
 //sys	PidfdOpen(pid int, flags int) (fd int, err error) = SYS_PIDFD_OPEN
 //sys	PidfdGetfd(pidfd int, targetfd int, flags int) (fd int, err error) = SYS_PIDFD_GETFD
 //sys	PidfdSendSignal(pidfd int, sig Signal, info *Siginfo, flags int) (err error) = SYS_PIDFD_SEND_SIGNAL
+//sys	ProcessMadvise(pidfd int, iovs []RemoteIovec, advice int, flags uint) (n int, err error) = SYS_PROCESS_MADVISE
+//sys	ProcessMrelease(pidfd int, flags uint) (err error) = SYS_PROCESS_MRELEASE
 
 //sys	shmat(id int, addr uintptr, flag int) (ret uintptr, err error)
 //sys	shmctl(id int, cmd int, buf *SysvShmDesc) (result int, err error)
@@ -2582,6 +2750,44 @@ func PthreadSigmask(how int, set, oldset *Sigset_t) error {
 	return rtSigprocmask(how, set, oldset, _C__NSIG/8)
 }
 
+// Sigaltstack sets and/or gets the alternate signal stack of the calling
+// thread. If new is non-nil it becomes the thread's alternate stack; if old
+// is non-nil the previous setting is stored there.
+//
+// The Go runtime installs its own alternate stack on every thread it
+// creates. Callers replacing it should lock the goroutine to its thread with
+// runtime.LockOSThread and restore the previous stack before unlocking.
+//
+//sysnb	Sigaltstack(new *Stack_t, old *Stack_t) (err error)
+
+//sys	rtSigtimedwait(set *Sigset_t, info *Siginfo, timeout *Timespec, sigsetsize uintptr) (sig int, err error) = SYS_RT_SIGTIMEDWAIT
+
+// SigtimedWait waits for one of the signals in set to become pending,
+// removes it from the pending set and returns it. The signals in set should
+// be blocked beforehand with PthreadSigmask. If info is non-nil it receives
+// the signal's siginfo. A nil timeout waits indefinitely; if the timeout
+// expires before a signal arrives, SigtimedWait returns EAGAIN.
+func SigtimedWait(set *Sigset_t, info *Siginfo, timeout *Timespec) (Signal, error) {
+	sig, err := rtSigtimedwait(set, info, timeout, _C__NSIG/8)
+	if err != nil {
+		return 0, err
+	}
+	return Signal(sig), nil
+}
+
+// Rtsigqueueinfo sends sig to the process pid together with info, which
+// carries a payload in its si_value field, see Siginfo.SetValue. Unless pid
+// is the caller's own process, info's Code must be negative, such as
+// SI_QUEUE; the kernel refuses to let a process impersonate signals sent by
+// kill or by the kernel.
+//
+//sysnb	Rtsigqueueinfo(pid int, sig syscall.Signal, info *Siginfo) (err error) = SYS_RT_SIGQUEUEINFO
+
+// Rttgsigqueueinfo is like Rtsigqueueinfo but sends sig to the thread tid in
+// thread group tgid.
+//
+//sysnb	Rttgsigqueueinfo(tgid int, tid int, sig syscall.Signal, info *Siginfo) (err error) = SYS_RT_TGSIGQUEUEINFO
+
 //sysnb	getresuid(ruid *_C_int, euid *_C_int, suid *_C_int)
 //sysnb	getresgid(rgid *_C_int, egid *_C_int, sgid *_C_int)
 
@@ -2653,5 +2859,74 @@ func SchedGetAttr(pid int, flags uint) (*SchedAttr, error) {
 	return attr, nil
 }
 
+//sysnb	SchedGetscheduler(pid int) (policy int, err error) = SYS_SCHED_GETSCHEDULER
+//sysnb	schedGetparam(pid int, param *_C_int) (err error) = SYS_SCHED_GETPARAM
+
+// SchedGetparam returns the static scheduling priority of the thread pid,
+// which is 0 for the non-real-time policies.
+func SchedGetparam(pid int) (priority int, err error) {
+	// struct sched_param has a single int member.
+	var p _C_int
+	err = schedGetparam(pid, &p)
+	return int(p), err
+}
+
+// SchedInfo returns the scheduling policy, static priority and nice value
+// of the thread pid, or of the calling thread if pid is 0. The policy is one
+// of the SCHED_* policy constants; SCHED_NORMAL is what POSIX calls
+// SCHED_OTHER. The SCHED_RESET_ON_FORK flag is not included.
+func SchedInfo(pid int) (policy int, priority int, nice int, err error) {
+	if policy, err = SchedGetscheduler(pid); err != nil {
+		return 0, 0, 0, err
+	}
+	if priority, err = SchedGetparam(pid); err != nil {
+		return 0, 0, 0, err
+	}
+	prio, err := Getpriority(PRIO_PROCESS, pid)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	// The system call returns 20-nice so that the result is never
+	// negative.
+	return policy &^ SCHED_RESET_ON_FORK, priority, 20 - prio, nil
+}
+
+//sysnb	schedRRGetInterval(pid int, interval *Timespec) (err error) = SYS_SCHED_RR_GET_INTERVAL
+
+// SchedRRGetInterval returns the round-robin time quantum of the thread
+// pid, or of the calling thread if pid is 0. It is zero for SCHED_FIFO
+// threads; for the non-real-time policies the kernel may report either zero
+// or the thread's current fair-share time slice.
+func SchedRRGetInterval(pid int) (time.Duration, error) {
+	var ts Timespec
+	if err := schedRRGetInterval(pid, &ts); err != nil {
+		return 0, err
+	}
+	return ts.Duration(), nil
+}
+
+// SetDeadlineScheduling switches the calling thread to the SCHED_DEADLINE
+// policy, which guarantees it runtime of CPU time within each period,
+// completed no later than deadline after the period starts. It returns
+// EINVAL unless 0 < runtime <= deadline <= period. SCHED_FLAG_RESET_ON_FORK
+// is set, so children revert to SCHED_NORMAL; without it the kernel refuses
+// to fork deadline threads.
+//
+// The policy applies to the calling thread only, so callers should lock
+// the goroutine to its thread with runtime.LockOSThread. The kernel rejects
+// bands that would exceed the system's deadline bandwidth with EBUSY.
+func SetDeadlineScheduling(runtime, deadline, period time.Duration) error {
+	if runtime <= 0 || runtime > deadline || deadline > period {
+		return EINVAL
+	}
+	return SchedSetAttr(0, &SchedAttr{
+		Policy:   SCHED_DEADLINE,
+		Flags:    SCHED_FLAG_RESET_ON_FORK,
+		Runtime:  uint64(runtime),
+		Deadline: uint64(deadline),
+		Period:   uint64(period),
+	}, 0)
+}
+
 //sys	Cachestat(fd uint, crange *CachestatRange, cstat *Cachestat_t, flags uint) (err error)
 //sys	Mseal(b []byte, flags uint) (err error)
@@ -6,6 +6,8 @@
 
 package unix
 
+import "unsafe"
+
 //sys	EpollWait(epfd int, events []EpollEvent, msec int) (n int, err error)
 //sys	Fadvise(fd int, offset int64, length int64, advice int) (err error) = SYS_FADVISE64
 //sys	Fchown(fd int, uid int, gid int) (err error)
@@ -143,3 +145,25 @@ func KexecFileLoad(kernelFd int, initrdFd int, cmdline string, flags int) error
 	}
 	return kexecFileLoad(kernelFd, initrdFd, cmdlineLen, cmdline, flags)
 }
+
+//sys	archPrctl(code int, addr uintptr) (err error) = SYS_ARCH_PRCTL
+//sys	archPrctlPtr(code int, addr unsafe.Pointer) (err error) = SYS_ARCH_PRCTL
+
+// ArchPrctl wraps the arch_prctl(2) system call, which sets or gets the
+// architecture-specific thread state of the calling thread.
+//
+// For ARCH_SET_FS and ARCH_SET_GS, addr is the new base address and the
+// returned value is zero. For ARCH_GET_FS and ARCH_GET_GS, addr is ignored
+// and the current base address is returned.
+//
+// The Go runtime keeps its thread-local storage at the FS base of every
+// thread. Changing it with ARCH_SET_FS will crash the program.
+func ArchPrctl(code int, addr uintptr) (uintptr, error) {
+	switch code {
+	case ARCH_GET_FS, ARCH_GET_GS:
+		var v uint64
+		err := archPrctlPtr(code, unsafe.Pointer(&v))
+		return uintptr(v), err
+	}
+	return 0, archPrctl(code, addr)
+}
@@ -0,0 +1,34 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && linux
+
+package unix_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestArchPrctl(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	fs, err := unix.ArchPrctl(unix.ARCH_GET_FS, 0)
+	if err != nil {
+		t.Fatalf("ArchPrctl(ARCH_GET_FS): %v", err)
+	}
+	if fs == 0 {
+		t.Fatal("ArchPrctl(ARCH_GET_FS) = 0, want the runtime's TLS base")
+	}
+	again, err := unix.ArchPrctl(unix.ARCH_GET_FS, 0)
+	if err != nil {
+		t.Fatalf("ArchPrctl(ARCH_GET_FS): %v", err)
+	}
+	if again != fs {
+		t.Errorf("ArchPrctl(ARCH_GET_FS) = %#x, previously %#x", again, fs)
+	}
+}
@@ -263,6 +263,29 @@ func firstIPv4(t *testing.T, ifi *net.Interface) (net.IP, bool) {
 	return nil, false
 }
 
+// runOnLockedThread runs f on a new goroutine locked to its own thread,
+// for tests that change per-thread state irreversibly. The thread is never
+// unlocked, so it exits with the goroutine. f cannot call t.Skip or
+// t.Fatal; the test is skipped or fails with its error instead.
+func runOnLockedThread(t *testing.T, f func() (skip bool, err error)) {
+	t.Helper()
+	type result struct {
+		skip bool
+		err  error
+	}
+	resc := make(chan result, 1)
+	go func() {
+		runtime.LockOSThread()
+		skip, err := f()
+		resc <- result{skip, err}
+	}()
+	if res := <-resc; res.skip {
+		t.Skip(res.err)
+	} else if res.err != nil {
+		t.Fatal(res.err)
+	}
+}
+
 func TestPidfd(t *testing.T) {
 	// Start a child process which will sleep for 1 hour; longer than the 10
 	// minute default Go test timeout.
@@ -935,6 +958,47 @@ func TestEpoll(t *testing.T) {
 	}
 }
 
+func TestEpollPwait2(t *testing.T) {
+	efd, err := unix.EpollCreate1(unix.EPOLL_CLOEXEC)
+	if err != nil {
+		t.Fatalf("EpollCreate1: %v", err)
+	}
+	defer unix.Close(efd)
+
+	var p [2]int
+	if err := unix.Pipe2(p[:], unix.O_CLOEXEC); err != nil {
+		t.Fatalf("Pipe2: %v", err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+
+	ev := unix.EpollEvent{Events: unix.EPOLLIN, Fd: int32(p[0])}
+	if err := unix.EpollCtl(efd, unix.EPOLL_CTL_ADD, p[0], &ev); err != nil {
+		t.Fatalf("EpollCtl: %v", err)
+	}
+
+	events := make([]unix.EpollEvent, 4)
+	timeout := unix.NsecToTimespec(int64(100 * time.Microsecond))
+	n, err := unix.EpollPwait2(efd, events, &timeout, nil)
+	if err == unix.ENOSYS {
+		t.Skip("epoll_pwait2 not supported")
+	}
+	if err != nil || n != 0 {
+		t.Fatalf("EpollPwait2 on an empty pipe = %d, %v; want 0, nil", n, err)
+	}
+
+	if _, err := unix.Write(p[1], []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	n, err = unix.EpollPwait2(efd, events, &timeout, nil)
+	if err != nil {
+		t.Fatalf("EpollPwait2: %v", err)
+	}
+	if n != 1 || events[0].Fd != int32(p[0]) || events[0].Events&unix.EPOLLIN == 0 {
+		t.Errorf("EpollPwait2 = %d events (first %+v), want EPOLLIN on fd %d", n, events[0], p[0])
+	}
+}
+
 func TestPrctlRetInt(t *testing.T) {
 	skipc := make(chan bool, 1)
 	skip := func() {
@@ -1069,6 +1133,34 @@ func TestOpenat2(t *testing.T) {
 	if err != unix.EXDEV {
 		t.Errorf("Openat2 should fail with EXDEV, got %v", err)
 	}
+
+	// open with RESOLVE_IN_ROOT, ".." of dirfd is dirfd itself
+	how.Resolve = unix.RESOLVE_IN_ROOT
+	fd, err = unix.Openat2(dirfd, "symlink", how)
+	if err != nil {
+		t.Fatalf("Openat2 with RESOLVE_IN_ROOT should succeed, got %v", err)
+	}
+	var st, root unix.Stat_t
+	if err := unix.Fstat(fd, &st); err != nil {
+		t.Fatal(err)
+	}
+	unix.Close(fd)
+	if err := unix.Fstat(dirfd, &root); err != nil {
+		t.Fatal(err)
+	}
+	if st.Ino != root.Ino || st.Dev != root.Dev {
+		t.Errorf("Openat2 with RESOLVE_IN_ROOT escaped the root directory")
+	}
+
+	// open with RESOLVE_NO_SYMLINKS, should result in ELOOP
+	how.Resolve = unix.RESOLVE_NO_SYMLINKS
+	fd, err = unix.Openat2(dirfd, "symlink", how)
+	if err == nil {
+		unix.Close(fd)
+	}
+	if err != unix.ELOOP {
+		t.Errorf("Openat2 should fail with ELOOP, got %v", err)
+	}
 }
 
 func TestIoctlFileDedupeRange(t *testing.T) {
@@ -1271,3 +1363,301 @@ func TestSockaddrALG(t *testing.T) {
 		t.Fatalf("got: %q, want: %q", got, exp)
 	}
 }
+
+func TestRecvfromTrunc(t *testing.T) {
+	recv, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Socket: %v", err)
+	}
+	defer unix.Close(recv)
+	if err := unix.Bind(recv, &unix.SockaddrInet4{Addr: [4]byte{127, 0, 0, 1}}); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+	addr, err := unix.Getsockname(recv)
+	if err != nil {
+		t.Fatalf("Getsockname: %v", err)
+	}
+
+	send, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Socket: %v", err)
+	}
+	defer unix.Close(send)
+	msg := bytes.Repeat([]byte("datagram"), 128)
+	if err := unix.Sendto(send, msg, 0, addr); err != nil {
+		t.Fatalf("Sendto: %v", err)
+	}
+
+	buf := make([]byte, 16)
+	n, from, err := unix.Recvfrom(recv, buf, unix.MSG_TRUNC)
+	if err != nil {
+		t.Fatalf("Recvfrom: %v", err)
+	}
+	if n != len(msg) {
+		t.Errorf("Recvfrom with MSG_TRUNC returned n = %d, want %d", n, len(msg))
+	}
+	if !bytes.Equal(buf, msg[:len(buf)]) {
+		t.Errorf("Recvfrom returned %q, want %q", buf, msg[:len(buf)])
+	}
+	if sa, ok := from.(*unix.SockaddrInet4); !ok || sa.Addr != [4]byte{127, 0, 0, 1} {
+		t.Errorf("Recvfrom returned sender %#v, want 127.0.0.1", from)
+	}
+}
+
+func TestSetsockoptAttachFilter(t *testing.T) {
+	// A UDP socket is filtered just like a packet socket, without
+	// requiring privileges or depending on unrelated traffic.
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_NONBLOCK|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Socket: %v", err)
+	}
+	defer unix.Close(fd)
+	if err := unix.Bind(fd, &unix.SockaddrInet4{Addr: [4]byte{127, 0, 0, 1}}); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+	addr, err := unix.Getsockname(fd)
+	if err != nil {
+		t.Fatalf("Getsockname: %v", err)
+	}
+
+	// ret #0: drop every packet.
+	dropAll := []unix.SockFilter{{Code: unix.BPF_RET | unix.BPF_K, K: 0}}
+	prog := &unix.SockFprog{Len: uint16(len(dropAll)), Filter: &dropAll[0]}
+	if err := unix.SetsockoptAttachFilter(fd, prog); err != nil {
+		t.Fatalf("SetsockoptAttachFilter: %v", err)
+	}
+
+	buf := make([]byte, 16)
+	if err := unix.Sendto(fd, []byte("dropped"), 0, addr); err != nil {
+		t.Fatalf("Sendto: %v", err)
+	}
+	if n, _, err := unix.Recvfrom(fd, buf, 0); err != unix.EAGAIN {
+		t.Errorf("Recvfrom with drop-all filter: got %q, %v, want EAGAIN", buf[:max(n, 0)], err)
+	}
+
+	if err := unix.SetsockoptDetachFilter(fd); err != nil {
+		t.Fatalf("SetsockoptDetachFilter: %v", err)
+	}
+	if err := unix.Sendto(fd, []byte("passed"), 0, addr); err != nil {
+		t.Fatalf("Sendto: %v", err)
+	}
+	n, _, err := unix.Recvfrom(fd, buf, 0)
+	if err != nil {
+		t.Fatalf("Recvfrom after detaching the filter: %v", err)
+	}
+	if got := string(buf[:n]); got != "passed" {
+		t.Errorf("Recvfrom = %q, want %q", got, "passed")
+	}
+
+	if err := unix.SetsockoptDetachFilter(fd); err != unix.ENOENT {
+		t.Errorf("SetsockoptDetachFilter without a filter: got %v, want ENOENT", err)
+	}
+}
+
+func TestSetsockoptSelectErrQueue(t *testing.T) {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	for _, on := range []bool{true, false} {
+		if err := unix.SetsockoptSelectErrQueue(fd, on); err != nil {
+			t.Fatalf("SetsockoptSelectErrQueue(%v): %v", on, err)
+		}
+		v, err := unix.GetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_SELECT_ERR_QUEUE)
+		if err != nil {
+			t.Fatalf("GetsockoptInt(SO_SELECT_ERR_QUEUE): %v", err)
+		}
+		if got := v != 0; got != on {
+			t.Errorf("SO_SELECT_ERR_QUEUE = %d after SetsockoptSelectErrQueue(%v)", v, on)
+		}
+	}
+}
+
+func TestGetSocketCookie(t *testing.T) {
+	var cookies [2]uint64
+	for i := range cookies {
+		fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer unix.Close(fd)
+		cookies[i], err = unix.GetSocketCookie(fd)
+		if err == unix.ENOPROTOOPT {
+			t.Skip("SO_COOKIE not supported")
+		}
+		if err != nil {
+			t.Fatalf("GetSocketCookie: %v", err)
+		}
+		if again, err := unix.GetSocketCookie(fd); err != nil || again != cookies[i] {
+			t.Errorf("GetSocketCookie again = %#x, %v; want %#x", again, err, cookies[i])
+		}
+	}
+	if cookies[0] == cookies[1] {
+		t.Errorf("two sockets have the same cookie %#x", cookies[0])
+	}
+}
+
+func TestSignalNameRealtime(t *testing.T) {
+	const rtmin = 32
+	for _, tt := range []struct {
+		sig  syscall.Signal
+		name string
+	}{
+		{rtmin, "SIGRTMIN"},
+		{rtmin + 1, "SIGRTMIN+1"},
+		{rtmin + 15, "SIGRTMIN+15"},
+	} {
+		if got := unix.SignalName(tt.sig); got != tt.name {
+			t.Errorf("SignalName(%d) = %q, want %q", tt.sig, got, tt.name)
+		}
+		if got := unix.SignalNum(tt.name); got != tt.sig {
+			t.Errorf("SignalNum(%q) = %d, want %d", tt.name, got, tt.sig)
+		}
+	}
+
+	rtmax := unix.SignalNum("SIGRTMAX")
+	if rtmax < rtmin+30 {
+		t.Fatalf(`SignalNum("SIGRTMAX") = %d`, rtmax)
+	}
+	if got := unix.SignalName(rtmax); got != "SIGRTMAX" {
+		t.Errorf("SignalName(%d) = %q, want SIGRTMAX", rtmax, got)
+	}
+	if got := unix.SignalNum("SIGRTMAX-2"); got != rtmax-2 {
+		t.Errorf(`SignalNum("SIGRTMAX-2") = %d, want %d`, got, rtmax-2)
+	}
+	if got := unix.SignalName(rtmax + 1); got != "" {
+		t.Errorf("SignalName(%d) = %q, want \"\"", rtmax+1, got)
+	}
+
+	// Every real-time signal name round-trips.
+	for sig := syscall.Signal(rtmin); sig <= rtmax; sig++ {
+		if got := unix.SignalNum(unix.SignalName(sig)); got != sig {
+			t.Errorf("SignalNum(SignalName(%d)) = %d", sig, got)
+		}
+	}
+
+	for _, name := range []string{"SIGRTMIN-1", "SIGRTMAX+1", "SIGRTMIN+", "SIGRTMIN++1", "SIGRTMIN+x", "SIGRTMIN+99999999999999999999", "SIGRTMAX-1000"} {
+		if got := unix.SignalNum(name); got != 0 {
+			t.Errorf("SignalNum(%q) = %d, want 0", name, got)
+		}
+	}
+}
+
+func TestSigaltstack(t *testing.T) {
+	const size = 64 << 10
+	stack, err := unix.Mmap(-1, 0, size, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANON)
+	if err != nil {
+		t.Fatalf("Mmap: %v", err)
+	}
+	defer unix.Munmap(stack)
+
+	// The thread's alternate stack belongs to the runtime. Do the work on a
+	// locked thread that is discarded afterwards in case restoring fails.
+	errc := make(chan error, 1)
+	go func() {
+		runtime.LockOSThread()
+		errc <- func() error {
+			var orig unix.Stack_t
+			if err := unix.Sigaltstack(nil, &orig); err != nil {
+				return fmt.Errorf("Sigaltstack(nil, &orig): %v", err)
+			}
+			ss := unix.Stack_t{Sp: &stack[0], Size: size}
+			if err := unix.Sigaltstack(&ss, nil); err != nil {
+				return fmt.Errorf("Sigaltstack(&ss, nil): %v", err)
+			}
+			var old unix.Stack_t
+			if err := unix.Sigaltstack(&orig, &old); err != nil {
+				return fmt.Errorf("Sigaltstack(&orig, &old): %v", err)
+			}
+			if old.Sp != &stack[0] || old.Size != size {
+				return fmt.Errorf("got stack %p with size %d, want %p with size %d", old.Sp, old.Size, &stack[0], size)
+			}
+			if old.Flags&unix.SS_DISABLE != 0 {
+				return fmt.Errorf("alternate stack unexpectedly disabled: flags %#x", old.Flags)
+			}
+			return nil
+		}()
+	}()
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExecveat(t *testing.T) {
+	switch os.Getenv("GO_WANT_HELPER_PROCESS") {
+	case "exec":
+		err := func() error {
+			exe, err := os.Executable()
+			if err != nil {
+				return err
+			}
+			argv := []string{exe, "-test.run=^TestExecveat$"}
+			env := execHelperEnv("exit")
+
+			dir, err := os.MkdirTemp("", "execveat")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(dir)
+			if err := os.Symlink(exe, filepath.Join(dir, "link")); err != nil {
+				return err
+			}
+			dirfd, err := unix.Open(dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+			if err != nil {
+				return err
+			}
+			if err := unix.Execveat(dirfd, "link", argv, env, unix.AT_SYMLINK_NOFOLLOW); err != unix.ELOOP {
+				return fmt.Errorf("Execveat of a symlink with AT_SYMLINK_NOFOLLOW: got %v, want ELOOP", err)
+			}
+
+			fd, err := unix.Open(exe, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+			if err != nil {
+				return err
+			}
+			return unix.Execveat(fd, "", argv, env, unix.AT_EMPTY_PATH)
+		}()
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	case "exit":
+		os.Exit(42)
+	}
+	runExecHelper(t, "TestExecveat")
+}
+
+func TestExecveatRlimitNofile(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") == "exec" {
+		// Go raised the soft limit set by the shell at startup; the program
+		// executed must see it restored.
+		sh, err := exec.LookPath("sh")
+		if err == nil {
+			err = unix.Execveat(unix.AT_FDCWD, sh, []string{"sh", "-c", `[ "$(ulimit -Sn)" = 512 ] && exit 42; ulimit -Sn`}, os.Environ(), 0)
+		}
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip(err)
+	}
+	var lim unix.Rlimit
+	if err := unix.Getrlimit(unix.RLIMIT_NOFILE, &lim); err != nil {
+		t.Fatal(err)
+	}
+	if lim.Max <= 513 {
+		t.Skipf("RLIMIT_NOFILE hard limit %d", lim.Max)
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(sh, "-c", `ulimit -Sn 512 && exec "$0" -test.run=^TestExecveatRlimitNofile$`, exe)
+	cmd.Env = execHelperEnv("exec")
+	out, err := cmd.CombinedOutput()
+	var ee *exec.ExitError
+	if !errors.As(err, &ee) || ee.ExitCode() != 42 {
+		t.Fatalf("helper process: %v, want exit status 42\n%s", err, out)
+	}
+}
@@ -9,6 +9,8 @@ package unix
 import (
 	"bytes"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"unsafe"
@@ -61,6 +63,8 @@ func ErrnoName(e syscall.Errno) string {
 }
 
 // SignalName returns the signal name for signal number s.
+// On Linux, real-time signals are named "SIGRTMIN", "SIGRTMIN+n" and
+// "SIGRTMAX", using the kernel's value of SIGRTMIN.
 func SignalName(s syscall.Signal) string {
 	i := sort.Search(len(signalList), func(i int) bool {
 		return signalList[i].num >= s
@@ -68,12 +72,24 @@ func SignalName(s syscall.Signal) string {
 	if i < len(signalList) && signalList[i].num == s {
 		return signalList[i].name
 	}
+	if sigrtmin > sigrtmax {
+		return ""
+	}
+	switch {
+	case s == sigrtmin:
+		return "SIGRTMIN"
+	case s == sigrtmax:
+		return "SIGRTMAX"
+	case s > sigrtmin && s < sigrtmax:
+		return "SIGRTMIN+" + strconv.Itoa(int(s-sigrtmin))
+	}
 	return ""
 }
 
 // SignalNum returns the syscall.Signal for signal named s,
 // or 0 if a signal with such name is not found.
 // The signal name should start with "SIG".
+// On Linux, real-time signals may be named "SIGRTMIN+n" or "SIGRTMAX-n".
 func SignalNum(s string) syscall.Signal {
 	signalNameMapOnce.Do(func() {
 		signalNameMap = make(map[string]syscall.Signal, len(signalList))
@@ -81,7 +97,44 @@ func SignalNum(s string) syscall.Signal {
 			signalNameMap[signal.name] = signal.num
 		}
 	})
-	return signalNameMap[s]
+	if sig, ok := signalNameMap[s]; ok {
+		return sig
+	}
+	return rtSignalNum(s)
+}
+
+// rtSignalNum parses the name of a real-time signal.
+func rtSignalNum(s string) syscall.Signal {
+	if sigrtmin > sigrtmax {
+		return 0
+	}
+	base, sign := syscall.Signal(sigrtmin), 1
+	rest, ok := strings.CutPrefix(s, "SIGRTMIN")
+	if !ok {
+		base, sign = sigrtmax, -1
+		if rest, ok = strings.CutPrefix(s, "SIGRTMAX"); !ok {
+			return 0
+		}
+	}
+	sig := base
+	if rest != "" {
+		op := byte('+')
+		if sign < 0 {
+			op = '-'
+		}
+		if len(rest) < 2 || rest[0] != op || rest[1] < '0' || rest[1] > '9' {
+			return 0
+		}
+		n, err := strconv.Atoi(rest[1:])
+		if err != nil || n > sigrtmax-sigrtmin {
+			return 0
+		}
+		sig = base + syscall.Signal(sign*n)
+	}
+	if sig < sigrtmin || sig > sigrtmax {
+		return 0
+	}
+	return sig
 }
 
 // clen returns the index of the first NULL byte in n or len(n) if n contains no NULL byte.
@@ -335,6 +388,11 @@ func GetsockoptUint64(fd, level, opt int) (value uint64, err error) {
 	return n, err
 }
 
+// Recvfrom receives a message from a socket using the recvfrom system call
+// and returns the number of bytes received along with the sender's address,
+// if any. On Linux, if flags includes MSG_TRUNC and the socket is a datagram
+// or raw socket, n is the real length of the datagram even if it was larger
+// than p, so n may exceed len(p).
 func Recvfrom(fd int, p []byte, flags int) (n int, from Sockaddr, err error) {
 	var rsa RawSockaddrAny
 	var len _Socklen = SizeofSockaddrAny
@@ -473,6 +531,8 @@ func Send(s int, buf []byte, flags int) (err error) {
 	return sendto(s, buf, flags, nil, 0)
 }
 
+// Sendto sends p on the socket fd to the address to using the sendto system
+// call. If to is nil, the socket's connected peer is used.
 func Sendto(fd int, p []byte, flags int, to Sockaddr) (err error) {
 	var ptr unsafe.Pointer
 	var salen _Socklen
@@ -494,6 +554,16 @@ func SetsockoptInt(fd, level, opt int, value int) (err error) {
 	return setsockopt(fd, level, opt, unsafe.Pointer(&n), 4)
 }
 
+// setsockoptBool sets the boolean socket option opt to 1 if on is true and
+// to 0 otherwise.
+func setsockoptBool(fd, level, opt int, on bool) error {
+	value := 0
+	if on {
+		value = 1
+	}
+	return SetsockoptInt(fd, level, opt, value)
+}
+
 func SetsockoptInet4Addr(fd, level, opt int, value [4]byte) (err error) {
 	return setsockopt(fd, level, opt, unsafe.Pointer(&value[0]), 4)
 }
@@ -597,6 +667,28 @@ func Lutimes(path string, tv []Timeval) error {
 	return UtimesNanoAt(AT_FDCWD, path, ts, AT_SYMLINK_NOFOLLOW)
 }
 
+// MkdiratMode creates the directory path relative to dirfd with exactly the
+// permission bits in mode. Mkdirat applies the process umask to mode, and
+// the umask cannot be changed safely in a multithreaded program, so
+// MkdiratMode instead creates the directory with Mkdirat and then sets its
+// mode with fchmod(2). If the mode cannot be set, the new directory is
+// removed.
+func MkdiratMode(dirfd int, path string, mode uint32) error {
+	if err := Mkdirat(dirfd, path, mode); err != nil {
+		return err
+	}
+	fd, err := Openat(dirfd, path, O_RDONLY|O_DIRECTORY|O_NOFOLLOW|O_CLOEXEC, 0)
+	if err == nil {
+		err = Fchmod(fd, mode)
+		Close(fd)
+	}
+	if err != nil {
+		Unlinkat(dirfd, path, AT_REMOVEDIR)
+		return err
+	}
+	return nil
+}
+
 // emptyIovecs reports whether there are no bytes in the slice of Iovec.
 func emptyIovecs(iov []Iovec) bool {
 	for i := range iov {
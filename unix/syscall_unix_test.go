@@ -90,6 +90,8 @@ func TestErrnoSignalName(t *testing.T) {
 		{syscall.SIGHUP, "SIGHUP"},
 		{syscall.SIGPIPE, "SIGPIPE"},
 		{syscall.SIGSEGV, "SIGSEGV"},
+		{syscall.SIGKILL, "SIGKILL"},
+		{0, ""},
 	}
 
 	for _, ts := range testSignals {
@@ -110,6 +112,7 @@ func TestSignalNum(t *testing.T) {
 		{"SIGHUP", syscall.SIGHUP},
 		{"SIGPIPE", syscall.SIGPIPE},
 		{"SIGSEGV", syscall.SIGSEGV},
+		{"SIGTERM", syscall.SIGTERM},
 		{"NONEXISTS", 0},
 	}
 	for _, ts := range testSignals {
@@ -881,6 +884,82 @@ func TestRenameat(t *testing.T) {
 	}
 }
 
+func TestUnlinkat(t *testing.T) {
+	dir := t.TempDir()
+	dirfd, err := unix.Open(dir, unix.O_RDONLY, 0)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer unix.Close(dirfd)
+
+	if err := os.Mkdir(filepath.Join(dir, "subdir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "file"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// A directory is only removed with AT_REMOVEDIR, and a file only
+	// without it.
+	if err := unix.Unlinkat(dirfd, "subdir", 0); err == nil {
+		t.Error("Unlinkat of a directory without AT_REMOVEDIR unexpectedly succeeded")
+	}
+	if err := unix.Unlinkat(dirfd, "file", unix.AT_REMOVEDIR); err == nil {
+		t.Error("Unlinkat of a file with AT_REMOVEDIR unexpectedly succeeded")
+	}
+
+	if err := unix.Unlinkat(dirfd, "subdir", unix.AT_REMOVEDIR); err != nil {
+		t.Errorf("Unlinkat(subdir, AT_REMOVEDIR): %v", err)
+	}
+	if err := unix.Unlinkat(dirfd, "file", 0); err != nil {
+		t.Errorf("Unlinkat(file, 0): %v", err)
+	}
+	for _, name := range []string{"subdir", "file"} {
+		if _, err := os.Lstat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Errorf("Lstat(%s) after Unlinkat: got %v, want not exist", name, err)
+		}
+	}
+}
+
+func TestMkdiratMode(t *testing.T) {
+	dir := t.TempDir()
+	dirfd, err := unix.Open(dir, unix.O_RDONLY, 0)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer unix.Close(dirfd)
+
+	// Use a umask that would strip bits from the requested mode.
+	old := unix.Umask(0077)
+	defer unix.Umask(old)
+
+	for _, tt := range []struct {
+		name string
+		mode uint32
+	}{
+		{"private", 0700},
+		{"shared", 0775},
+	} {
+		if err := unix.MkdiratMode(dirfd, tt.name, tt.mode); err != nil {
+			t.Fatalf("MkdiratMode(%s, %#o): %v", tt.name, tt.mode, err)
+		}
+		var st unix.Stat_t
+		if err := unix.Fstatat(dirfd, tt.name, &st, 0); err != nil {
+			t.Fatalf("Fstatat: %v", err)
+		}
+		if st.Mode&unix.S_IFMT != unix.S_IFDIR {
+			t.Errorf("%s is not a directory", tt.name)
+		}
+		if got := uint32(st.Mode) & 0777; got != tt.mode {
+			t.Errorf("%s has mode %#o, want %#o", tt.name, got, tt.mode)
+		}
+	}
+
+	if err := unix.MkdiratMode(dirfd, "private", 0700); err != unix.EEXIST {
+		t.Errorf("MkdiratMode of existing directory: got %v, want EEXIST", err)
+	}
+}
+
 func TestUtimesNanoAt(t *testing.T) {
 	chtmpdir(t)
 
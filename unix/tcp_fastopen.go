@@ -0,0 +1,18 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || freebsd || linux
+
+package unix
+
+// SetTCPFastOpen enables TCP Fast Open (TFO) on the listening socket fd by
+// setting TCP_FASTOPEN. It must be called before Listen. On Linux, qlen is
+// the maximum number of pending TFO requests that have not completed the
+// three-way handshake; on Darwin and FreeBSD only whether qlen is non-zero
+// matters. Whether the kernel actually accepts TFO connections is further
+// controlled by system-wide settings such as the net.ipv4.tcp_fastopen
+// sysctl on Linux.
+func SetTCPFastOpen(fd int, qlen int) error {
+	return SetsockoptInt(fd, IPPROTO_TCP, TCP_FASTOPEN, qlen)
+}
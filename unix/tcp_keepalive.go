@@ -0,0 +1,40 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || solaris
+
+package unix
+
+import "time"
+
+// SetKeepAlive enables TCP keepalive probes on fd by setting SO_KEEPALIVE,
+// and tunes them: idle is the time the connection must be idle before the
+// first probe is sent, interval the time between probes and count the
+// number of unanswered probes after which the connection is dropped. The
+// durations are rounded up to whole seconds. A parameter that is zero or
+// negative is left at the system default.
+//
+// On Darwin the idle time is set with TCP_KEEPALIVE, elsewhere with
+// TCP_KEEPIDLE.
+func SetKeepAlive(fd int, idle, interval time.Duration, count int) error {
+	if err := setsockoptBool(fd, SOL_SOCKET, SO_KEEPALIVE, true); err != nil {
+		return err
+	}
+	if idle > 0 {
+		if err := SetsockoptInt(fd, IPPROTO_TCP, tcpKeepIdle, durationSeconds(idle)); err != nil {
+			return err
+		}
+	}
+	if interval > 0 {
+		if err := SetsockoptInt(fd, IPPROTO_TCP, TCP_KEEPINTVL, durationSeconds(interval)); err != nil {
+			return err
+		}
+	}
+	if count > 0 {
+		if err := SetsockoptInt(fd, IPPROTO_TCP, TCP_KEEPCNT, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build aix || dragonfly || freebsd || linux || netbsd || solaris
+
+package unix
+
+// tcpKeepIdle is the socket option setting the idle time before TCP
+// keepalive probes are sent.
+const tcpKeepIdle = TCP_KEEPIDLE
@@ -0,0 +1,9 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+// tcpKeepIdle is the socket option setting the idle time before TCP
+// keepalive probes are sent. Darwin calls it TCP_KEEPALIVE.
+const tcpKeepIdle = TCP_KEEPALIVE
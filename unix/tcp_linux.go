@@ -0,0 +1,99 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Linux-specific TCP socket options.
+
+package unix
+
+import (
+	"fmt"
+	"time"
+)
+
+// SetTCPFastOpenConnect sets TCP_FASTOPEN_CONNECT on the client socket fd
+// (Linux >= 4.11). When on, connect(2) returns immediately and the data of
+// the first write is carried in the SYN if a TFO cookie is available.
+func SetTCPFastOpenConnect(fd int, on bool) error {
+	return setsockoptBool(fd, IPPROTO_TCP, TCP_FASTOPEN_CONNECT, on)
+}
+
+// SetTCPQuickAck sets TCP_QUICKACK on fd. When on, acknowledgments are sent
+// immediately rather than delayed. The kernel may leave quick ACK mode again
+// on its own, so the option is usually set again after each read.
+func SetTCPQuickAck(fd int, on bool) error {
+	return setsockoptBool(fd, IPPROTO_TCP, TCP_QUICKACK, on)
+}
+
+// SetTCPCork sets TCP_CORK on fd. While on, partial frames are held back
+// until the option is cleared or a full frame can be sent.
+func SetTCPCork(fd int, on bool) error {
+	return setsockoptBool(fd, IPPROTO_TCP, TCP_CORK, on)
+}
+
+// SetTCPUserTimeout sets TCP_USER_TIMEOUT on fd: the maximum time that
+// transmitted data may remain unacknowledged before the connection is
+// forcibly closed. The duration is rounded up to whole milliseconds; zero
+// restores the system default.
+func SetTCPUserTimeout(fd int, d time.Duration) error {
+	if d < 0 {
+		return EINVAL
+	}
+	return SetsockoptInt(fd, IPPROTO_TCP, TCP_USER_TIMEOUT, int((d+time.Millisecond-1)/time.Millisecond))
+}
+
+// SocketOptions is a set of options applied to a TCP socket by
+// ConfigureAcceptedSocket. Zero fields leave the corresponding option
+// unchanged, which for a newly accepted socket is the system default.
+type SocketOptions struct {
+	NoDelay           bool          // TCP_NODELAY: disable Nagle's algorithm
+	Cork              bool          // TCP_CORK: hold back partial frames
+	KeepAlive         bool          // SO_KEEPALIVE: send keepalive probes
+	KeepAliveIdle     time.Duration // TCP_KEEPIDLE: idle time before the first probe
+	KeepAliveInterval time.Duration // TCP_KEEPINTVL: time between probes
+	KeepAliveCount    int           // TCP_KEEPCNT: unanswered probes before dropping
+	RecvBuf           int           // SO_RCVBUF: receive buffer size in bytes
+	SendBuf           int           // SO_SNDBUF: send buffer size in bytes
+}
+
+// ConfigureAcceptedSocket applies opts to the TCP socket fd, typically just
+// returned by Accept4, with one setsockopt call per non-zero field. It stops
+// at the first failure and returns an error naming the option that wraps
+// the Errno. Keepalive durations are rounded up to whole seconds.
+func ConfigureAcceptedSocket(fd int, opts SocketOptions) error {
+	for _, o := range []struct {
+		name       string
+		level, opt int
+		on         bool
+	}{
+		{"TCP_NODELAY", IPPROTO_TCP, TCP_NODELAY, opts.NoDelay},
+		{"TCP_CORK", IPPROTO_TCP, TCP_CORK, opts.Cork},
+		{"SO_KEEPALIVE", SOL_SOCKET, SO_KEEPALIVE, opts.KeepAlive},
+	} {
+		if !o.on {
+			continue
+		}
+		if err := setsockoptBool(fd, o.level, o.opt, true); err != nil {
+			return fmt.Errorf("setsockopt %s: %w", o.name, err)
+		}
+	}
+	for _, o := range []struct {
+		name       string
+		level, opt int
+		value      int
+	}{
+		{"TCP_KEEPIDLE", IPPROTO_TCP, TCP_KEEPIDLE, durationSeconds(opts.KeepAliveIdle)},
+		{"TCP_KEEPINTVL", IPPROTO_TCP, TCP_KEEPINTVL, durationSeconds(opts.KeepAliveInterval)},
+		{"TCP_KEEPCNT", IPPROTO_TCP, TCP_KEEPCNT, opts.KeepAliveCount},
+		{"SO_RCVBUF", SOL_SOCKET, SO_RCVBUF, opts.RecvBuf},
+		{"SO_SNDBUF", SOL_SOCKET, SO_SNDBUF, opts.SendBuf},
+	} {
+		if o.value <= 0 {
+			continue
+		}
+		if err := SetsockoptInt(fd, o.level, o.opt, o.value); err != nil {
+			return fmt.Errorf("setsockopt %s: %w", o.name, err)
+		}
+	}
+	return nil
+}
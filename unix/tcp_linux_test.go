@@ -0,0 +1,277 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// tcpSocket returns a new IPv4 TCP socket that is closed when t finishes.
+func tcpSocket(t *testing.T) int {
+	t.Helper()
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Socket: %v", err)
+	}
+	t.Cleanup(func() { unix.Close(fd) })
+	return fd
+}
+
+// tcpConnPair returns the file descriptors of both ends of a connected
+// loopback TCP connection, which are closed when t finishes.
+func tcpConnPair(t *testing.T) (client, server int) {
+	t.Helper()
+	ln := tcpSocket(t)
+	if err := unix.Bind(ln, &unix.SockaddrInet4{Addr: [4]byte{127, 0, 0, 1}}); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+	if err := unix.Listen(ln, 1); err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	addr, err := unix.Getsockname(ln)
+	if err != nil {
+		t.Fatalf("Getsockname: %v", err)
+	}
+	client = tcpSocket(t)
+	if err := unix.Connect(client, addr); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	server, _, err = unix.Accept4(ln, unix.SOCK_CLOEXEC)
+	if err != nil {
+		t.Fatalf("Accept4: %v", err)
+	}
+	t.Cleanup(func() { unix.Close(server) })
+	return client, server
+}
+
+func TestSetTCPFastOpen(t *testing.T) {
+	if b, err := os.ReadFile("/proc/sys/net/ipv4/tcp_fastopen"); err == nil && strings.TrimSpace(string(b)) == "0" {
+		t.Skip("TCP Fast Open is disabled by net.ipv4.tcp_fastopen")
+	}
+
+	fd := tcpSocket(t)
+	if err := unix.Bind(fd, &unix.SockaddrInet4{Addr: [4]byte{127, 0, 0, 1}}); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+	if err := unix.SetTCPFastOpen(fd, 16); err != nil {
+		t.Fatalf("SetTCPFastOpen: %v", err)
+	}
+	if err := unix.Listen(fd, 16); err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	qlen, err := unix.GetsockoptInt(fd, unix.IPPROTO_TCP, unix.TCP_FASTOPEN)
+	if err != nil {
+		t.Fatalf("GetsockoptInt(TCP_FASTOPEN): %v", err)
+	}
+	if qlen != 16 {
+		t.Errorf("TCP_FASTOPEN = %d, want 16", qlen)
+	}
+
+	cli := tcpSocket(t)
+	for _, on := range []bool{true, false} {
+		if err := unix.SetTCPFastOpenConnect(cli, on); err != nil {
+			t.Fatalf("SetTCPFastOpenConnect(%v): %v", on, err)
+		}
+		v, err := unix.GetsockoptInt(cli, unix.IPPROTO_TCP, unix.TCP_FASTOPEN_CONNECT)
+		if err != nil {
+			t.Fatalf("GetsockoptInt(TCP_FASTOPEN_CONNECT): %v", err)
+		}
+		if (v != 0) != on {
+			t.Errorf("TCP_FASTOPEN_CONNECT = %d after SetTCPFastOpenConnect(%v)", v, on)
+		}
+	}
+}
+
+func TestSetTCPToggles(t *testing.T) {
+	client, server := tcpConnPair(t)
+	for _, fd := range []int{client, server} {
+		for _, on := range []bool{true, false, true} {
+			if err := unix.SetTCPNoDelay(fd, on); err != nil {
+				t.Fatalf("SetTCPNoDelay(%v): %v", on, err)
+			}
+			v, err := unix.GetsockoptInt(fd, unix.IPPROTO_TCP, unix.TCP_NODELAY)
+			if err != nil {
+				t.Fatalf("GetsockoptInt(TCP_NODELAY): %v", err)
+			}
+			if (v != 0) != on {
+				t.Errorf("TCP_NODELAY = %d after SetTCPNoDelay(%v)", v, on)
+			}
+			if err := unix.SetTCPQuickAck(fd, on); err != nil {
+				t.Errorf("SetTCPQuickAck(%v): %v", on, err)
+			}
+			if err := unix.SetTCPCork(fd, on); err != nil {
+				t.Errorf("SetTCPCork(%v): %v", on, err)
+			}
+			v, err = unix.GetsockoptInt(fd, unix.IPPROTO_TCP, unix.TCP_CORK)
+			if err != nil {
+				t.Fatalf("GetsockoptInt(TCP_CORK): %v", err)
+			}
+			if (v != 0) != on {
+				t.Errorf("TCP_CORK = %d after SetTCPCork(%v)", v, on)
+			}
+		}
+	}
+}
+
+func TestSetKeepAlive(t *testing.T) {
+	fd := tcpSocket(t)
+	if err := unix.SetKeepAlive(fd, 30*time.Second, 1500*time.Millisecond, 4); err != nil {
+		t.Fatalf("SetKeepAlive: %v", err)
+	}
+	for _, tt := range []struct {
+		name       string
+		level, opt int
+		want       int
+	}{
+		{"SO_KEEPALIVE", unix.SOL_SOCKET, unix.SO_KEEPALIVE, 1},
+		{"TCP_KEEPIDLE", unix.IPPROTO_TCP, unix.TCP_KEEPIDLE, 30},
+		{"TCP_KEEPINTVL", unix.IPPROTO_TCP, unix.TCP_KEEPINTVL, 2},
+		{"TCP_KEEPCNT", unix.IPPROTO_TCP, unix.TCP_KEEPCNT, 4},
+	} {
+		v, err := unix.GetsockoptInt(fd, tt.level, tt.opt)
+		if err != nil {
+			t.Fatalf("GetsockoptInt(%s): %v", tt.name, err)
+		}
+		if v != tt.want {
+			t.Errorf("%s = %d, want %d", tt.name, v, tt.want)
+		}
+	}
+
+	// Non-positive parameters leave the current values alone.
+	if err := unix.SetKeepAlive(fd, 0, -1, 0); err != nil {
+		t.Fatalf("SetKeepAlive: %v", err)
+	}
+	if v, err := unix.GetsockoptInt(fd, unix.IPPROTO_TCP, unix.TCP_KEEPIDLE); err != nil || v != 30 {
+		t.Errorf("TCP_KEEPIDLE = %d, %v after SetKeepAlive(0, -1, 0), want 30", v, err)
+	}
+}
+
+func TestSetTCPUserTimeout(t *testing.T) {
+	fd := tcpSocket(t)
+	if err := unix.SetTCPUserTimeout(fd, 5*time.Second); err != nil {
+		t.Fatalf("SetTCPUserTimeout: %v", err)
+	}
+	v, err := unix.GetsockoptInt(fd, unix.IPPROTO_TCP, unix.TCP_USER_TIMEOUT)
+	if err != nil {
+		t.Fatalf("GetsockoptInt(TCP_USER_TIMEOUT): %v", err)
+	}
+	if v != 5000 {
+		t.Errorf("TCP_USER_TIMEOUT = %d, want 5000", v)
+	}
+	if err := unix.SetTCPUserTimeout(fd, -time.Second); err != unix.EINVAL {
+		t.Errorf("SetTCPUserTimeout(-1s): got error %v, want EINVAL", err)
+	}
+}
+
+func TestSetLinger(t *testing.T) {
+	fd := tcpSocket(t)
+	for _, tt := range []struct {
+		d     time.Duration
+		onoff int32
+		secs  int32
+	}{
+		{2 * time.Second, 1, 2},
+		{0, 1, 0},
+		{-1, 0, 0},
+	} {
+		if err := unix.SetLinger(fd, tt.d); err != nil {
+			t.Fatalf("SetLinger(%v): %v", tt.d, err)
+		}
+		l, err := unix.GetsockoptLinger(fd, unix.SOL_SOCKET, unix.SO_LINGER)
+		if err != nil {
+			t.Fatalf("GetsockoptLinger: %v", err)
+		}
+		if l.Onoff != tt.onoff || l.Linger != tt.secs {
+			t.Errorf("after SetLinger(%v) got %+v, want {Onoff:%d Linger:%d}", tt.d, *l, tt.onoff, tt.secs)
+		}
+	}
+}
+
+func TestConfigureAcceptedSocket(t *testing.T) {
+	_, server := tcpConnPair(t)
+	opts := unix.SocketOptions{
+		NoDelay:           true,
+		KeepAlive:         true,
+		KeepAliveIdle:     90 * time.Second,
+		KeepAliveInterval: 1500 * time.Millisecond,
+		KeepAliveCount:    4,
+		RecvBuf:           64 << 10,
+		SendBuf:           64 << 10,
+	}
+	if err := unix.ConfigureAcceptedSocket(server, opts); err != nil {
+		t.Fatalf("ConfigureAcceptedSocket: %v", err)
+	}
+	for _, tt := range []struct {
+		name       string
+		level, opt int
+		want       int
+	}{
+		{"TCP_NODELAY", unix.IPPROTO_TCP, unix.TCP_NODELAY, 1},
+		{"SO_KEEPALIVE", unix.SOL_SOCKET, unix.SO_KEEPALIVE, 1},
+		{"TCP_KEEPIDLE", unix.IPPROTO_TCP, unix.TCP_KEEPIDLE, 90},
+		{"TCP_KEEPINTVL", unix.IPPROTO_TCP, unix.TCP_KEEPINTVL, 2},
+		{"TCP_KEEPCNT", unix.IPPROTO_TCP, unix.TCP_KEEPCNT, 4},
+		{"TCP_CORK", unix.IPPROTO_TCP, unix.TCP_CORK, 0},
+	} {
+		v, err := unix.GetsockoptInt(server, tt.level, tt.opt)
+		if err != nil {
+			t.Fatalf("GetsockoptInt(%s): %v", tt.name, err)
+		}
+		if v != tt.want {
+			t.Errorf("%s = %d, want %d", tt.name, v, tt.want)
+		}
+	}
+	// The kernel doubles the requested buffer size to allow for its own
+	// bookkeeping.
+	if v, err := unix.GetsockoptInt(server, unix.SOL_SOCKET, unix.SO_RCVBUF); err != nil || v < opts.RecvBuf {
+		t.Errorf("SO_RCVBUF = %d, %v; want at least %d", v, err, opts.RecvBuf)
+	}
+
+	var p [2]int
+	if err := unix.Pipe(p[:]); err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+	err := unix.ConfigureAcceptedSocket(p[0], unix.SocketOptions{SendBuf: 1 << 16})
+	if !errors.Is(err, unix.ENOTSOCK) || !strings.Contains(err.Error(), "SO_SNDBUF") {
+		t.Errorf("ConfigureAcceptedSocket on a pipe: got %v, want SO_SNDBUF error wrapping ENOTSOCK", err)
+	}
+}
+
+func TestGetsockoptMemInfo(t *testing.T) {
+	client, _ := tcpConnPair(t)
+	before, err := unix.GetsockoptMemInfo(client)
+	if err != nil {
+		t.Fatalf("GetsockoptMemInfo: %v", err)
+	}
+	if before.Sndbuf == 0 || before.Rcvbuf == 0 {
+		t.Errorf("GetsockoptMemInfo = %+v, want non-zero buffer sizes", before)
+	}
+
+	// While corked, a write shorter than the MSS stays in the write queue.
+	if err := unix.SetTCPCork(client, true); err != nil {
+		t.Fatalf("SetTCPCork: %v", err)
+	}
+	const n = 100
+	if _, err := unix.Write(client, make([]byte, n)); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	after, err := unix.GetsockoptMemInfo(client)
+	if err != nil {
+		t.Fatalf("GetsockoptMemInfo: %v", err)
+	}
+	if after.Wmem_queued < before.Wmem_queued+n {
+		t.Errorf("Wmem_queued = %d after queuing %d bytes, was %d", after.Wmem_queued, n, before.Wmem_queued)
+	}
+}
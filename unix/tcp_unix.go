@@ -0,0 +1,36 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+
+// Typed helpers for common TCP socket options.
+
+package unix
+
+import "time"
+
+// SetTCPNoDelay sets TCP_NODELAY on fd. When on, segments are sent as soon
+// as possible instead of being coalesced by Nagle's algorithm.
+func SetTCPNoDelay(fd int, on bool) error {
+	return setsockoptBool(fd, IPPROTO_TCP, TCP_NODELAY, on)
+}
+
+// SetLinger sets SO_LINGER on fd. If d is negative, lingering is disabled
+// and close(2) returns immediately while the kernel sends any remaining
+// data in the background. If d is zero, close discards unsent data and
+// resets the connection. Otherwise close blocks for up to d, rounded up to
+// whole seconds, until the remaining data has been sent.
+func SetLinger(fd int, d time.Duration) error {
+	var l Linger
+	if d >= 0 {
+		l.Onoff = 1
+		l.Linger = int32(durationSeconds(d))
+	}
+	return SetsockoptLinger(fd, SOL_SOCKET, SO_LINGER, &l)
+}
+
+// durationSeconds returns d in whole seconds, rounded up.
+func durationSeconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
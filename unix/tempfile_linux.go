@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+const tempChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// Mkostempsat creates a new, unique file relative to the directory dirfd,
+// emulating mkostemps(3). The template must end in "XXXXXX" followed by a
+// suffix of suffixLen bytes, such as "fileXXXXXX.tmp" with a suffixLen of 4;
+// the Xs are replaced with random characters to form the name. The file is
+// created with mode 0600 and opened for reading and writing with
+// O_CREAT|O_EXCL, in addition to flags, which may include for example
+// O_CLOEXEC or O_APPEND.
+//
+// It returns the file descriptor and the name of the new file relative to
+// dirfd. If the template is malformed, Mkostempsat returns EINVAL.
+func Mkostempsat(dirfd int, template string, suffixLen int, flags int) (fd int, name string, err error) {
+	if suffixLen < 0 || len(template) < suffixLen+6 {
+		return -1, "", EINVAL
+	}
+	end := len(template) - suffixLen
+	if !strings.HasSuffix(template[:end], "XXXXXX") {
+		return -1, "", EINVAL
+	}
+	b := []byte(template)
+	for try := 0; try < 10000; try++ {
+		for i := end - 6; i < end; i++ {
+			b[i] = tempChars[rand.IntN(len(tempChars))]
+		}
+		name = string(b)
+		fd, err = Openat(dirfd, name, O_RDWR|O_CREAT|O_EXCL|flags, 0600)
+		if err != EEXIST {
+			if err != nil {
+				return -1, "", err
+			}
+			return fd, name, nil
+		}
+	}
+	return -1, "", EEXIST
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestMkostempsat(t *testing.T) {
+	dirfd, err := unix.Open(t.TempDir(), unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(dirfd)
+
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		fd, name, err := unix.Mkostempsat(dirfd, "tempXXXXXX.tmp", 4, unix.O_CLOEXEC)
+		if err != nil {
+			t.Fatalf("Mkostempsat: %v", err)
+		}
+		flags, err := unix.FcntlInt(uintptr(fd), unix.F_GETFD, 0)
+		unix.Close(fd)
+		if err != nil {
+			t.Fatalf("fcntl: %v", err)
+		}
+		if flags&unix.FD_CLOEXEC == 0 {
+			t.Errorf("%s was not opened with O_CLOEXEC", name)
+		}
+		if !strings.HasPrefix(name, "temp") || !strings.HasSuffix(name, ".tmp") || len(name) != len("tempXXXXXX.tmp") || strings.Contains(name, "XXXXXX") {
+			t.Errorf("Mkostempsat returned malformed name %q", name)
+		}
+		if seen[name] {
+			t.Errorf("Mkostempsat returned %q twice", name)
+		}
+		seen[name] = true
+
+		var st unix.Stat_t
+		if err := unix.Fstatat(dirfd, name, &st, 0); err != nil {
+			t.Fatalf("Fstatat(%s): %v", name, err)
+		}
+		if perm := st.Mode & 0777; perm != 0600 {
+			t.Errorf("%s has mode %#o, want 0600", name, perm)
+		}
+	}
+
+	for _, tt := range []struct {
+		template  string
+		suffixLen int
+	}{
+		{"temp.tmp", 4},
+		{"tempXXXXX.tmp", 4},
+		{"tempXXXXXX.tmp", 3},
+		{"XXXXXX", 1},
+		{"XXXXXX", -1},
+	} {
+		if _, _, err := unix.Mkostempsat(dirfd, tt.template, tt.suffixLen, 0); err != unix.EINVAL {
+			t.Errorf("Mkostempsat(%q, %d): got error %v, want EINVAL", tt.template, tt.suffixLen, err)
+		}
+	}
+}
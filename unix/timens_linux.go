@@ -0,0 +1,61 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Clock offsets of time namespaces, see time_namespaces(7).
+
+package unix
+
+import (
+	"strconv"
+	"time"
+)
+
+// TimeNamespaceOffsets holds the clock offsets of a time namespace relative
+// to the initial time namespace.
+type TimeNamespaceOffsets struct {
+	Monotonic time.Duration // offset of CLOCK_MONOTONIC and its variants
+	Boottime  time.Duration // offset of CLOCK_BOOTTIME and its variants
+}
+
+// SetTimeNamespaceOffsets sets the clock offsets of the time namespace that
+// the children of task pid will be created in, by writing
+// /proc/<pid>/timens_offsets. The pid may be a thread ID; 0 denotes the
+// calling thread.
+//
+// The namespace must have been created with Unshare(CLONE_NEWTIME) and no
+// process may have entered it yet; the kernel returns EACCES otherwise.
+// Since unshare acts on the calling thread, callers should lock the
+// goroutine to its thread with runtime.LockOSThread.
+func SetTimeNamespaceOffsets(pid int, off TimeNamespaceOffsets) error {
+	if pid == 0 {
+		// There is no per-thread timens_offsets under thread-self,
+		// but /proc/<tid> refers to the individual thread.
+		pid = Gettid()
+	}
+	path := "/proc/" + strconv.Itoa(pid) + "/timens_offsets"
+	var b []byte
+	for _, o := range []struct {
+		clock int
+		d     time.Duration
+	}{
+		{CLOCK_MONOTONIC, off.Monotonic},
+		{CLOCK_BOOTTIME, off.Boottime},
+	} {
+		ts := NsecToTimespec(int64(o.d))
+		b = strconv.AppendInt(b, int64(o.clock), 10)
+		b = append(b, ' ')
+		b = strconv.AppendInt(b, int64(ts.Sec), 10)
+		b = append(b, ' ')
+		b = strconv.AppendInt(b, int64(ts.Nsec), 10)
+		b = append(b, '\n')
+	}
+	fd, err := Open(path, O_WRONLY|O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer Close(fd)
+	// The offsets must be written in a single write call.
+	_, err = Write(fd, b)
+	return err
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func monotonicNow(t *testing.T) time.Duration {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		t.Fatalf("ClockGettime: %v", err)
+	}
+	return ts.Duration()
+}
+
+func TestSetTimeNamespaceOffsets(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") == "1" {
+		fmt.Println(int64(monotonicNow(t)))
+		os.Exit(0)
+	}
+	if os.Getuid() != 0 {
+		t.Skip("creating a time namespace requires root")
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const offset = 48 * time.Hour
+	before := monotonicNow(t)
+	var out []byte
+	// The new time namespace only applies to children forked from the
+	// thread.
+	runOnLockedThread(t, func() (bool, error) {
+		if err := unix.Unshare(unix.CLONE_NEWTIME); err != nil {
+			return err == unix.EINVAL || err == unix.EPERM, fmt.Errorf("Unshare(CLONE_NEWTIME): %v", err)
+		}
+		if err := unix.SetTimeNamespaceOffsets(0, unix.TimeNamespaceOffsets{Monotonic: offset}); err != nil {
+			return false, fmt.Errorf("SetTimeNamespaceOffsets: %v", err)
+		}
+		cmd := exec.Command(exe, "-test.run=^TestSetTimeNamespaceOffsets$")
+		cmd.Env = append(os.Environ(), "GO_WANT_HELPER_PROCESS=1")
+		var err error
+		out, err = cmd.Output()
+		return false, err
+	})
+	after := monotonicNow(t)
+
+	v, err := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+	if err != nil {
+		t.Fatalf("parsing child output %q: %v", out, err)
+	}
+	if child := time.Duration(v); child < before+offset || child > after+offset {
+		t.Errorf("child CLOCK_MONOTONIC = %v, want between %v and %v", child, before+offset, after+offset)
+	}
+}
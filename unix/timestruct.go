@@ -40,6 +40,18 @@ func TimeToTimespec(t time.Time) (Timespec, error) {
 	return ts, nil
 }
 
+// TimeToTimeval converts t into a Timeval, truncating to microseconds.
+// As with TimeToTimespec, it returns a zero Timeval and ERANGE if t is out
+// of the valid range of Timeval.
+func TimeToTimeval(t time.Time) (Timeval, error) {
+	sec := t.Unix()
+	tv := setTimeval(sec, int64(t.Nanosecond())/1e3)
+	if int64(tv.Sec) != sec {
+		return Timeval{}, ERANGE
+	}
+	return tv, nil
+}
+
 // TimevalToNsec returns the time stored in tv as nanoseconds.
 func TimevalToNsec(tv Timeval) int64 { return tv.Nano() }
 
@@ -74,3 +86,36 @@ func (ts *Timespec) Nano() int64 {
 func (tv *Timeval) Nano() int64 {
 	return int64(tv.Sec)*1e9 + int64(tv.Usec)*1000
 }
+
+// Duration returns the time stored in ts as a time.Duration.
+func (ts *Timespec) Duration() time.Duration {
+	return time.Duration(ts.Nano())
+}
+
+// Duration returns the time stored in tv as a time.Duration.
+func (tv *Timeval) Duration() time.Duration {
+	return time.Duration(tv.Nano())
+}
+
+// Time returns the time stored in ts, taken as an offset from the Unix
+// epoch, as a time.Time.
+func (ts *Timespec) Time() time.Time {
+	return time.Unix(ts.Unix())
+}
+
+// Time returns the time stored in tv, taken as an offset from the Unix
+// epoch, as a time.Time.
+func (tv *Timeval) Time() time.Time {
+	return time.Unix(tv.Unix())
+}
+
+// GettimeofdayTime returns the current wall clock time as reported by
+// Gettimeofday. Unlike time.Now, the result carries no monotonic clock
+// reading and has microsecond resolution.
+func GettimeofdayTime() (time.Time, error) {
+	var tv Timeval
+	if err := Gettimeofday(&tv); err != nil {
+		return time.Time{}, err
+	}
+	return tv.Time(), nil
+}
@@ -52,3 +52,48 @@ func TestTimeToTimespec(t *testing.T) {
 		}
 	}
 }
+
+func TestTimespecDuration(t *testing.T) {
+	for _, d := range []time.Duration{0, 1, 999999999, 1500 * time.Millisecond, -1, -2500 * time.Millisecond, 1<<31*time.Nanosecond + 7} {
+		ts := unix.NsecToTimespec(int64(d))
+		if got := ts.Duration(); got != d {
+			t.Errorf("NsecToTimespec(%v).Duration() = %v", d, got)
+		}
+		// NsecToTimeval rounds up to the next microsecond.
+		if d >= 0 {
+			tv := unix.NsecToTimeval(int64(d))
+			want := (d + time.Microsecond - 1).Truncate(time.Microsecond)
+			if got := tv.Duration(); got != want {
+				t.Errorf("NsecToTimeval(%v).Duration() = %v, want %v", d, got, want)
+			}
+		}
+	}
+}
+
+func TestTimespecTime(t *testing.T) {
+	now := time.Unix(1700000000, 123456789)
+	ts, err := unix.TimeToTimespec(now)
+	if err != nil {
+		t.Fatalf("TimeToTimespec: %v", err)
+	}
+	if got := ts.Time(); !got.Equal(now) {
+		t.Errorf("Timespec.Time() = %v, want %v", got, now)
+	}
+	tv, err := unix.TimeToTimeval(now)
+	if err != nil {
+		t.Fatalf("TimeToTimeval: %v", err)
+	}
+	if got, want := tv.Time(), now.Truncate(time.Microsecond); !got.Equal(want) {
+		t.Errorf("Timeval.Time() = %v, want %v", got, want)
+	}
+}
+
+func TestGettimeofdayTime(t *testing.T) {
+	got, err := unix.GettimeofdayTime()
+	if err != nil {
+		t.Fatalf("GettimeofdayTime: %v", err)
+	}
+	if d := time.Since(got); d < -time.Second || d > time.Second {
+		t.Errorf("GettimeofdayTime() = %v, which is %v away from time.Now()", got, d)
+	}
+}
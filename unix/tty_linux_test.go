@@ -0,0 +1,131 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+// openPty opens a new pseudo-terminal and returns its master and the path
+// of its slave. The master is closed when t finishes.
+func openPty(t *testing.T) (master int, slave string) {
+	t.Helper()
+	master, err := unix.Open("/dev/ptmx", unix.O_RDWR|unix.O_NOCTTY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Skipf("cannot open /dev/ptmx: %v", err)
+	}
+	t.Cleanup(func() { unix.Close(master) })
+	if err := unix.IoctlSetPointerInt(master, unix.TIOCSPTLCK, 0); err != nil {
+		t.Fatalf("TIOCSPTLCK: %v", err)
+	}
+	n, err := unix.IoctlGetUint32(master, unix.TIOCGPTN)
+	if err != nil {
+		t.Fatalf("TIOCGPTN: %v", err)
+	}
+	return master, "/dev/pts/" + strconv.FormatUint(uint64(n), 10)
+}
+
+// runTTYHelper runs the test named by run in a child process whose
+// standard input is the terminal at tty, using the given SysProcAttr, and
+// returns its trimmed output.
+func runTTYHelper(t *testing.T, run, tty string, attr *syscall.SysProcAttr) string {
+	t.Helper()
+	f, err := os.OpenFile(tty, os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(exe, "-test.run=^"+run+"$", "--", tty)
+	cmd.Env = append(os.Environ(), "GO_WANT_HELPER_PROCESS=1")
+	cmd.Stdin = f
+	cmd.SysProcAttr = attr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("child process: %q, %v", out, err)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestTcgetpgrp(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") == "1" {
+		// The child's controlling terminal is its standard input.
+		pgid := unix.Getpgrp()
+		if err := unix.Tcsetpgrp(0, pgid); err != nil {
+			fmt.Printf("Tcsetpgrp: %v\n", err)
+			os.Exit(1)
+		}
+		fg, err := unix.Tcgetpgrp(0)
+		if err != nil {
+			fmt.Printf("Tcgetpgrp: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(pgid, fg)
+		os.Exit(0)
+	}
+
+	_, slave := openPty(t)
+	out := runTTYHelper(t, "TestTcgetpgrp", slave, &syscall.SysProcAttr{Setsid: true, Setctty: true})
+	var pgid, fg int
+	if _, err := fmt.Sscan(out, &pgid, &fg); err != nil {
+		t.Fatalf("cannot parse child output %q: %v", out, err)
+	}
+	if fg != pgid {
+		t.Errorf("Tcgetpgrp = %d, want the child's process group %d", fg, pgid)
+	}
+}
+
+func TestSetControllingTTY(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") == "1" {
+		sid, err := unix.Setsid()
+		if err != nil {
+			fmt.Printf("Setsid: %v\n", err)
+			os.Exit(1)
+		}
+		fd, err := unix.Open(flag.Arg(0), unix.O_RDWR|unix.O_NOCTTY, 0)
+		if err != nil {
+			fmt.Printf("Open: %v\n", err)
+			os.Exit(1)
+		}
+		if err := unix.SetControllingTTY(fd); err != nil {
+			fmt.Printf("SetControllingTTY: %v\n", err)
+			os.Exit(1)
+		}
+		fg, err := unix.Tcgetpgrp(fd)
+		if err != nil {
+			fmt.Printf("Tcgetpgrp: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(sid, unix.Getpgrp(), fg)
+		os.Exit(0)
+	}
+
+	_, slave := openPty(t)
+	out := runTTYHelper(t, "TestSetControllingTTY", slave, nil)
+	var sid, pgid, fg int
+	if _, err := fmt.Sscan(out, &sid, &pgid, &fg); err != nil {
+		t.Fatalf("cannot parse child output %q: %v", out, err)
+	}
+	if pgid != sid {
+		t.Errorf("child's process group %d differs from its new session %d", pgid, sid)
+	}
+	if fg != pgid {
+		t.Errorf("Tcgetpgrp = %d, want the child's process group %d", fg, pgid)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+
+package unix
+
+import "unsafe"
+
+// Tcgetpgrp returns the process group ID of the foreground process group of
+// the terminal fd, which must be the calling process's controlling
+// terminal.
+func Tcgetpgrp(fd int) (pgid int, err error) {
+	var pgrp int32
+	err = ioctlPtr(fd, TIOCGPGRP, unsafe.Pointer(&pgrp))
+	return int(pgrp), err
+}
+
+// Tcsetpgrp makes the process group pgid the foreground process group of
+// the terminal fd, which must be the calling process's controlling
+// terminal.
+func Tcsetpgrp(fd int, pgid int) error {
+	return IoctlSetPointerInt(fd, TIOCSPGRP, pgid)
+}
+
+// SetControllingTTY makes the terminal fd the controlling terminal of the
+// calling process with TIOCSCTTY. The caller must be a session leader
+// without a controlling terminal, as after Setsid, and the terminal must
+// not already be the controlling terminal of another session.
+func SetControllingTTY(fd int) error {
+	return IoctlSetInt(fd, TIOCSCTTY, 0)
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// TUN/TAP device helpers.
+
+package unix
+
+// OpenTun opens /dev/net/tun and attaches the new file descriptor to the
+// TUN/TAP interface name with TUNSETIFF, creating the interface if it does
+// not exist. The flags select the device type and options, for example
+// IFF_TUN|IFF_NO_PI. If name is empty or contains a %d verb, the kernel
+// picks the interface name, which is returned as ifname.
+//
+// With IFF_MULTI_QUEUE, calling OpenTun again with the same name and flags
+// opens an additional queue of the same interface. Each queue is attached
+// when opened and can be detached and reattached with DetachTunQueue and
+// AttachTunQueue. The interface is removed once all its queues are closed,
+// unless it was made persistent with TUNSETPERSIST.
+func OpenTun(name string, flags uint16) (fd int, ifname string, err error) {
+	ifr, err := NewIfreq(name)
+	if err != nil {
+		return -1, "", err
+	}
+	ifr.SetUint16(flags)
+	fd, err = Open("/dev/net/tun", O_RDWR|O_CLOEXEC, 0)
+	if err != nil {
+		return -1, "", err
+	}
+	if err := IoctlIfreq(fd, TUNSETIFF, ifr); err != nil {
+		Close(fd)
+		return -1, "", err
+	}
+	return fd, ifr.Name(), nil
+}
+
+// AttachTunQueue reattaches the queue fd of a multi-queue TUN/TAP interface
+// opened with OpenTun, so that it again receives packets.
+func AttachTunQueue(fd int) error {
+	return setTunQueue(fd, IFF_ATTACH_QUEUE)
+}
+
+// DetachTunQueue detaches the queue fd of a multi-queue TUN/TAP interface
+// opened with OpenTun. No packets are delivered to a detached queue, but
+// the file descriptor stays valid and can be reattached with
+// AttachTunQueue.
+func DetachTunQueue(fd int) error {
+	return setTunQueue(fd, IFF_DETACH_QUEUE)
+}
+
+func setTunQueue(fd int, flags uint16) error {
+	var ifr Ifreq
+	ifr.SetUint16(flags)
+	return IoctlIfreq(fd, TUNSETQUEUE, &ifr)
+}
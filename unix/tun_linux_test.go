@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestTunMultiQueue(t *testing.T) {
+	const flags = unix.IFF_TUN | unix.IFF_NO_PI | unix.IFF_MULTI_QUEUE
+	fd0, name, err := unix.OpenTun("gotest%d", flags)
+	switch err {
+	case nil:
+	case unix.ENOENT, unix.ENODEV, unix.EPERM, unix.EACCES:
+		t.Skipf("cannot create a tun device: %v", err)
+	default:
+		t.Fatalf("OpenTun: %v", err)
+	}
+	defer unix.Close(fd0)
+	if !strings.HasPrefix(name, "gotest") || strings.Contains(name, "%") {
+		t.Errorf("OpenTun returned interface name %q", name)
+	}
+
+	fd1, name1, err := unix.OpenTun(name, flags)
+	if err != nil {
+		t.Fatalf("OpenTun of second queue: %v", err)
+	}
+	defer unix.Close(fd1)
+	if name1 != name {
+		t.Errorf("second queue attached to %q, want %q", name1, name)
+	}
+
+	for _, fd := range []int{fd0, fd1} {
+		if err := unix.DetachTunQueue(fd); err != nil {
+			t.Fatalf("DetachTunQueue: %v", err)
+		}
+		if err := unix.AttachTunQueue(fd); err != nil {
+			t.Fatalf("AttachTunQueue: %v", err)
+		}
+	}
+
+	// A single-queue device cannot have its queue detached.
+	fd2, _, err := unix.OpenTun("", unix.IFF_TUN|unix.IFF_NO_PI)
+	if err != nil {
+		t.Fatalf("OpenTun: %v", err)
+	}
+	defer unix.Close(fd2)
+	if err := unix.DetachTunQueue(fd2); err != unix.EINVAL {
+		t.Errorf("DetachTunQueue of single-queue device: got %v, want EINVAL", err)
+	}
+}
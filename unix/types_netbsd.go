@@ -289,6 +289,10 @@ const (
 	POLLWRNORM = C.POLLWRNORM
 )
 
+// Signal Sets
+
+type Sigset_t C.sigset_t
+
 // Sysctl
 
 type Sysctlnode C.struct_sysctlnode
@@ -0,0 +1,111 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Page fault handling in user space with userfaultfd, see userfaultfd(2)
+// and ioctl_userfaultfd(2).
+
+package unix
+
+import "unsafe"
+
+// IoctlUffdioAPI performs the UFFDIO_API handshake that must precede any
+// other operation on a userfaultfd. api.Api must be UFFD_API and
+// api.Features the UFFD_FEATURE_* flags requested; on return the kernel
+// has filled in the supported features and the ioctls available, as a bit
+// mask of 1<<_UFFDIO_* numbers, in api.Ioctls.
+func IoctlUffdioAPI(fd int, api *UffdioApi) error {
+	return ioctlPtr(fd, UFFDIO_API, unsafe.Pointer(api))
+}
+
+// IoctlUffdioRegister registers the address range reg.Range with the
+// userfaultfd for the UFFDIO_REGISTER_MODE_* faults in reg.Mode. On return
+// reg.Ioctls holds the ioctls available to resolve faults in the range.
+func IoctlUffdioRegister(fd int, reg *UffdioRegister) error {
+	return ioctlPtr(fd, UFFDIO_REGISTER, unsafe.Pointer(reg))
+}
+
+// IoctlUffdioUnregister unregisters the address range r from the
+// userfaultfd.
+func IoctlUffdioUnregister(fd int, r *UffdioRange) error {
+	return ioctlPtr(fd, UFFDIO_UNREGISTER, unsafe.Pointer(r))
+}
+
+// IoctlUffdioWake wakes the threads waiting on faults in the address range
+// r, after it was resolved by an operation with a
+// UFFDIO_*_MODE_DONTWAKE mode.
+func IoctlUffdioWake(fd int, r *UffdioRange) error {
+	return ioctlPtr(fd, UFFDIO_WAKE, unsafe.Pointer(r))
+}
+
+// IoctlUffdioCopy resolves missing page faults by atomically copying c.Len
+// bytes from c.Src to the registered range at c.Dst. On return c.Copy holds
+// the number of bytes copied, which may be short of c.Len when the call
+// fails with EAGAIN, or a negated errno.
+func IoctlUffdioCopy(fd int, c *UffdioCopy) error {
+	return ioctlPtr(fd, UFFDIO_COPY, unsafe.Pointer(c))
+}
+
+// IoctlUffdioZeropage resolves missing page faults by mapping zero pages in
+// the range z.Range. On return z.Zeropage holds the number of bytes mapped
+// or a negated errno.
+func IoctlUffdioZeropage(fd int, z *UffdioZeropage) error {
+	return ioctlPtr(fd, UFFDIO_ZEROPAGE, unsafe.Pointer(z))
+}
+
+// IoctlUffdioWriteprotect write-protects the range wp.Range, registered
+// with UFFDIO_REGISTER_MODE_WP, if wp.Mode contains
+// UFFDIO_WRITEPROTECT_MODE_WP, and removes the protection otherwise,
+// resolving the write-protect faults in the range.
+func IoctlUffdioWriteprotect(fd int, wp *UffdioWriteprotect) error {
+	return ioctlPtr(fd, UFFDIO_WRITEPROTECT, unsafe.Pointer(wp))
+}
+
+// IoctlUffdioContinue resolves minor faults in the range c.Range,
+// registered with UFFDIO_REGISTER_MODE_MINOR, by mapping the pages already
+// present in the page cache. On return c.Mapped holds the number of bytes
+// mapped or a negated errno.
+func IoctlUffdioContinue(fd int, c *UffdioContinue) error {
+	return ioctlPtr(fd, UFFDIO_CONTINUE, unsafe.Pointer(c))
+}
+
+// ReadUffdMsgs reads pending events from the userfaultfd fd into msgs and
+// returns the number read. It blocks until at least one event is
+// available, unless fd was created with O_NONBLOCK in which case it
+// returns EAGAIN.
+func ReadUffdMsgs(fd int, msgs []UffdMsg) (int, error) {
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+	buf := unsafe.Slice((*byte)(unsafe.Pointer(&msgs[0])), len(msgs)*SizeofUffdMsg)
+	n, err := Read(fd, buf)
+	if err != nil {
+		return 0, err
+	}
+	return n / SizeofUffdMsg, nil
+}
+
+// Pagefault returns the arguments of a UFFD_EVENT_PAGEFAULT event: the
+// faulting address, the UFFD_PAGEFAULT_FLAG_* flags and, with
+// UFFD_FEATURE_THREAD_ID, the thread ID of the faulting thread.
+func (m *UffdMsg) Pagefault() (address, flags uint64, ptid uint32) {
+	return m.Arg[1], m.Arg[0], *(*uint32)(unsafe.Pointer(&m.Arg[2]))
+}
+
+// Fork returns the new userfaultfd created for the child of a
+// UFFD_EVENT_FORK event. The descriptor belongs to the caller.
+func (m *UffdMsg) Fork() (ufd int) {
+	return int(*(*uint32)(unsafe.Pointer(&m.Arg[0])))
+}
+
+// Remap returns the arguments of a UFFD_EVENT_REMAP event: the old and new
+// addresses of the range moved by mremap(2) and its length.
+func (m *UffdMsg) Remap() (from, to, length uint64) {
+	return m.Arg[0], m.Arg[1], m.Arg[2]
+}
+
+// Remove returns the range [start, end) of a UFFD_EVENT_REMOVE or
+// UFFD_EVENT_UNMAP event.
+func (m *UffdMsg) Remove() (start, end uint64) {
+	return m.Arg[0], m.Arg[1]
+}
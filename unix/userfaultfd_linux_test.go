@@ -0,0 +1,129 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"testing"
+	"unsafe"
+
+	"github.com/kononk-fox/sys/unix"
+)
+
+func TestUffdTypes(t *testing.T) {
+	if got := unsafe.Sizeof(unix.UffdMsg{}); got != unix.SizeofUffdMsg {
+		t.Errorf("sizeof UffdMsg: got %d, want %d", got, unix.SizeofUffdMsg)
+	}
+	if got := unsafe.Sizeof(unix.UffdioCopy{}); got != unix.SizeofUffdioCopy {
+		t.Errorf("sizeof UffdioCopy: got %d, want %d", got, unix.SizeofUffdioCopy)
+	}
+}
+
+func TestUserfaultfdCopy(t *testing.T) {
+	uffd, err := unix.Userfaultfd(unix.O_CLOEXEC)
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("userfaultfd not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("Userfaultfd: %v", err)
+	}
+	defer unix.Close(uffd)
+
+	api := unix.UffdioApi{Api: unix.UFFD_API}
+	if err := unix.IoctlUffdioAPI(uffd, &api); err != nil {
+		t.Fatalf("IoctlUffdioAPI: %v", err)
+	}
+	if api.Ioctls&(1<<(unix.UFFDIO_REGISTER&0xff)) == 0 {
+		t.Fatalf("UFFDIO_REGISTER not available: ioctls %#x", api.Ioctls)
+	}
+
+	pageSize := unix.Getpagesize()
+	mem, err := unix.Mmap(-1, 0, pageSize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANONYMOUS)
+	if err != nil {
+		t.Fatalf("Mmap: %v", err)
+	}
+	defer unix.Munmap(mem)
+	addr := uint64(uintptr(unsafe.Pointer(&mem[0])))
+
+	reg := unix.UffdioRegister{
+		Range: unix.UffdioRange{Start: addr, Len: uint64(pageSize)},
+		Mode:  unix.UFFDIO_REGISTER_MODE_MISSING,
+	}
+	if err := unix.IoctlUffdioRegister(uffd, &reg); err != nil {
+		t.Fatalf("IoctlUffdioRegister: %v", err)
+	}
+	if reg.Ioctls&(1<<(unix.UFFDIO_COPY&0xff)) == 0 {
+		t.Fatalf("UFFDIO_COPY not available for the range: ioctls %#x", reg.Ioctls)
+	}
+
+	var p [2]int
+	if err := unix.Pipe2(p[:], unix.O_CLOEXEC); err != nil {
+		t.Fatalf("Pipe2: %v", err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+
+	// Fault on the page from within a system call, so that the faulting
+	// thread is not stopped in Go code where it could block the garbage
+	// collector.
+	errc := make(chan error, 1)
+	go func() {
+		_, err := unix.Write(p[1], mem)
+		errc <- err
+	}()
+
+	msgs := make([]unix.UffdMsg, 4)
+	n, err := unix.ReadUffdMsgs(uffd, msgs)
+	if err != nil {
+		t.Fatalf("ReadUffdMsgs: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("ReadUffdMsgs: got %d events, want 1", n)
+	}
+	if msgs[0].Event != unix.UFFD_EVENT_PAGEFAULT {
+		t.Fatalf("event: got %#x, want UFFD_EVENT_PAGEFAULT", msgs[0].Event)
+	}
+	faultAddr, flags, _ := msgs[0].Pagefault()
+	if faultAddr&^uint64(pageSize-1) != addr {
+		t.Errorf("fault address: got %#x, want page %#x", faultAddr, addr)
+	}
+	if flags&unix.UFFD_PAGEFAULT_FLAG_WRITE != 0 {
+		t.Errorf("fault flags: got %#x, want a read fault", flags)
+	}
+
+	src := bytes.Repeat([]byte{0x42}, pageSize)
+	cp := unix.UffdioCopy{
+		Dst: addr,
+		Src: uint64(uintptr(unsafe.Pointer(&src[0]))),
+		Len: uint64(pageSize),
+	}
+	if err := unix.IoctlUffdioCopy(uffd, &cp); err != nil {
+		t.Fatalf("IoctlUffdioCopy: %v", err)
+	}
+	if cp.Copy != int64(pageSize) {
+		t.Errorf("IoctlUffdioCopy: copied %d bytes, want %d", cp.Copy, pageSize)
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	buf := make([]byte, pageSize)
+	if _, err := unix.Read(p[0], buf); err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if !bytes.Equal(buf, src) {
+		t.Errorf("faulted page does not hold the copied data")
+	}
+
+	// The page is now present, so the copy fails.
+	if err := unix.IoctlUffdioCopy(uffd, &cp); err != unix.EEXIST {
+		t.Errorf("IoctlUffdioCopy on a present page: got %v, want EEXIST", err)
+	}
+	if err := unix.IoctlUffdioUnregister(uffd, &reg.Range); err != nil {
+		t.Errorf("IoctlUffdioUnregister: %v", err)
+	}
+}
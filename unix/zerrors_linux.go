@@ -720,6 +720,12 @@ const (
 	CGROUP2_SUPER_MAGIC                         = 0x63677270
 	CGROUP_SUPER_MAGIC                          = 0x27e0eb
 	CIFS_SUPER_MAGIC                            = 0xff534d42
+	CLD_CONTINUED                               = 0x6
+	CLD_DUMPED                                  = 0x3
+	CLD_EXITED                                  = 0x1
+	CLD_KILLED                                  = 0x2
+	CLD_STOPPED                                 = 0x5
+	CLD_TRAPPED                                 = 0x4
 	CLOCK_BOOTTIME                              = 0x7
 	CLOCK_BOOTTIME_ALARM                        = 0x9
 	CLOCK_DEFAULT                               = 0x0
@@ -1523,6 +1529,66 @@ const (
 	IN_OPEN                                     = 0x20
 	IN_Q_OVERFLOW                               = 0x4000
 	IN_UNMOUNT                                  = 0x2000
+	IORING_CQE_F_BUFFER                         = 0x1
+	IORING_CQE_F_MORE                           = 0x2
+	IORING_CQE_F_NOTIF                          = 0x8
+	IORING_CQE_F_SOCK_NONEMPTY                  = 0x4
+	IORING_CQ_EVENTFD_DISABLED                  = 0x1
+	IORING_ENTER_EXT_ARG                        = 0x8
+	IORING_ENTER_GETEVENTS                      = 0x1
+	IORING_ENTER_REGISTERED_RING                = 0x10
+	IORING_ENTER_SQ_WAIT                        = 0x4
+	IORING_ENTER_SQ_WAKEUP                      = 0x2
+	IORING_FEAT_CQE_SKIP                        = 0x800
+	IORING_FEAT_CUR_PERSONALITY                 = 0x10
+	IORING_FEAT_EXT_ARG                         = 0x100
+	IORING_FEAT_FAST_POLL                       = 0x20
+	IORING_FEAT_LINKED_FILE                     = 0x1000
+	IORING_FEAT_NATIVE_WORKERS                  = 0x200
+	IORING_FEAT_NODROP                          = 0x2
+	IORING_FEAT_POLL_32BITS                     = 0x40
+	IORING_FEAT_RSRC_TAGS                       = 0x400
+	IORING_FEAT_RW_CUR_POS                      = 0x8
+	IORING_FEAT_SINGLE_MMAP                     = 0x1
+	IORING_FEAT_SQPOLL_NONFIXED                 = 0x80
+	IORING_FEAT_SUBMIT_STABLE                   = 0x4
+	IORING_FSYNC_DATASYNC                       = 0x1
+	IORING_OFF_CQ_RING                          = 0x8000000
+	IORING_OFF_MMAP_MASK                        = 0xf8000000
+	IORING_OFF_SQES                             = 0x10000000
+	IORING_OFF_SQ_RING                          = 0x0
+	IORING_SETUP_ATTACH_WQ                      = 0x20
+	IORING_SETUP_CLAMP                          = 0x10
+	IORING_SETUP_COOP_TASKRUN                   = 0x100
+	IORING_SETUP_CQE32                          = 0x800
+	IORING_SETUP_CQSIZE                         = 0x8
+	IORING_SETUP_DEFER_TASKRUN                  = 0x2000
+	IORING_SETUP_IOPOLL                         = 0x1
+	IORING_SETUP_NO_SQARRAY                     = 0x10000
+	IORING_SETUP_R_DISABLED                     = 0x40
+	IORING_SETUP_SINGLE_ISSUER                  = 0x1000
+	IORING_SETUP_SQE128                         = 0x400
+	IORING_SETUP_SQPOLL                         = 0x2
+	IORING_SETUP_SQ_AFF                         = 0x4
+	IORING_SETUP_SUBMIT_ALL                     = 0x80
+	IORING_SETUP_TASKRUN_FLAG                   = 0x200
+	IORING_SQ_CQ_OVERFLOW                       = 0x2
+	IORING_SQ_NEED_WAKEUP                       = 0x1
+	IORING_SQ_TASKRUN                           = 0x4
+	IORING_TIMEOUT_ABS                          = 0x1
+	IORING_TIMEOUT_BOOTTIME                     = 0x4
+	IORING_TIMEOUT_CLOCK_MASK                   = 0xc
+	IORING_TIMEOUT_ETIME_SUCCESS                = 0x20
+	IORING_TIMEOUT_REALTIME                     = 0x8
+	IORING_TIMEOUT_UPDATE                       = 0x2
+	IORING_TIMEOUT_UPDATE_MASK                  = 0x12
+	IOSQE_ASYNC                                 = 0x10
+	IOSQE_BUFFER_SELECT                         = 0x20
+	IOSQE_CQE_SKIP_SUCCESS                      = 0x40
+	IOSQE_FIXED_FILE                            = 0x1
+	IOSQE_IO_DRAIN                              = 0x2
+	IOSQE_IO_HARDLINK                           = 0x8
+	IOSQE_IO_LINK                               = 0x4
 	IPPROTO_AH                                  = 0x33
 	IPPROTO_BEETPH                              = 0x5e
 	IPPROTO_COMP                                = 0x6c
@@ -1809,6 +1875,7 @@ const (
 	LANDLOCK_ACCESS_FS_WRITE_FILE               = 0x2
 	LANDLOCK_ACCESS_NET_BIND_TCP                = 0x1
 	LANDLOCK_ACCESS_NET_CONNECT_TCP             = 0x2
+	LANDLOCK_CREATE_RULESET_ERRATA              = 0x2
 	LANDLOCK_CREATE_RULESET_VERSION             = 0x1
 	LANDLOCK_SCOPE_ABSTRACT_UNIX_SOCKET         = 0x1
 	LANDLOCK_SCOPE_SIGNAL                       = 0x2
@@ -1822,6 +1889,7 @@ const (
 	LINUX_REBOOT_CMD_SW_SUSPEND                 = 0xd000fce2
 	LINUX_REBOOT_MAGIC1                         = 0xfee1dead
 	LINUX_REBOOT_MAGIC2                         = 0x28121969
+	LISTMOUNT_REVERSE                           = 0x1
 	LOCK_EX                                     = 0x2
 	LOCK_NB                                     = 0x4
 	LOCK_SH                                     = 0x1
@@ -1843,6 +1911,7 @@ const (
 	LOOP_SET_STATUS_SETTABLE_FLAGS              = 0xc
 	LO_KEY_SIZE                                 = 0x20
 	LO_NAME_SIZE                                = 0x40
+	LSMT_ROOT                                   = 0xffffffffffffffff
 	LWTUNNEL_IP6_MAX                            = 0x8
 	LWTUNNEL_IP_MAX                             = 0x8
 	LWTUNNEL_IP_OPTS_MAX                        = 0x3
@@ -3018,6 +3087,14 @@ const (
 	SCM_SECURITY                                = 0x3
 	SCM_TIMESTAMP                               = 0x1d
 	SC_LOG_FLUSH                                = 0x100000
+	SECBIT_KEEP_CAPS                            = 0x10
+	SECBIT_KEEP_CAPS_LOCKED                     = 0x20
+	SECBIT_NOROOT                               = 0x1
+	SECBIT_NOROOT_LOCKED                        = 0x2
+	SECBIT_NO_CAP_AMBIENT_RAISE                 = 0x40
+	SECBIT_NO_CAP_AMBIENT_RAISE_LOCKED          = 0x80
+	SECBIT_NO_SETUID_FIXUP                      = 0x4
+	SECBIT_NO_SETUID_FIXUP_LOCKED               = 0x8
 	SECCOMP_ADDFD_FLAG_SEND                     = 0x2
 	SECCOMP_ADDFD_FLAG_SETFD                    = 0x1
 	SECCOMP_FILTER_FLAG_LOG                     = 0x2
@@ -3148,6 +3225,10 @@ const (
 	SIOCSMIIREG                                 = 0x8949
 	SIOCSRARP                                   = 0x8962
 	SIOCWANDEV                                  = 0x894a
+	SI_KERNEL                                   = 0x80
+	SI_QUEUE                                    = -0x1
+	SI_TKILL                                    = -0x6
+	SI_USER                                     = 0x0
 	SK_DIAG_BPF_STORAGE_MAX                     = 0x3
 	SK_DIAG_BPF_STORAGE_REQ_MAX                 = 0x1
 	SMACK_MAGIC                                 = 0x43415d53
@@ -3253,7 +3334,24 @@ const (
 	SPLICE_F_MOVE                               = 0x1
 	SPLICE_F_NONBLOCK                           = 0x2
 	SQUASHFS_MAGIC                              = 0x73717368
+	SS_DISABLE                                  = 0x2
+	SS_ONSTACK                                  = 0x1
 	STACK_END_MAGIC                             = 0x57ac6e9d
+	STATMOUNT_FS_SUBTYPE                        = 0x100
+	STATMOUNT_FS_TYPE                           = 0x20
+	STATMOUNT_MNT_BASIC                         = 0x2
+	STATMOUNT_MNT_GIDMAP                        = 0x4000
+	STATMOUNT_MNT_NS_ID                         = 0x40
+	STATMOUNT_MNT_OPTS                          = 0x80
+	STATMOUNT_MNT_POINT                         = 0x10
+	STATMOUNT_MNT_ROOT                          = 0x8
+	STATMOUNT_MNT_UIDMAP                        = 0x2000
+	STATMOUNT_OPT_ARRAY                         = 0x400
+	STATMOUNT_OPT_SEC_ARRAY                     = 0x800
+	STATMOUNT_PROPAGATE_FROM                    = 0x4
+	STATMOUNT_SB_BASIC                          = 0x1
+	STATMOUNT_SB_SOURCE                         = 0x200
+	STATMOUNT_SUPPORTED_MASK                    = 0x1000
 	STATX_ALL                                   = 0xfff
 	STATX_ATIME                                 = 0x20
 	STATX_ATTR_APPEND                           = 0x20
@@ -3517,6 +3615,38 @@ const (
 	UDP_SEGMENT                                 = 0x67
 	UDP_V4_FLOW                                 = 0x2
 	UDP_V6_FLOW                                 = 0x6
+	UFFDIO_CONTINUE_MODE_DONTWAKE               = 0x1
+	UFFDIO_COPY_MODE_DONTWAKE                   = 0x1
+	UFFDIO_COPY_MODE_WP                         = 0x2
+	UFFDIO_REGISTER_MODE_MINOR                  = 0x4
+	UFFDIO_REGISTER_MODE_MISSING                = 0x1
+	UFFDIO_REGISTER_MODE_WP                     = 0x2
+	UFFDIO_WRITEPROTECT_MODE_DONTWAKE           = 0x2
+	UFFDIO_WRITEPROTECT_MODE_WP                 = 0x1
+	UFFDIO_ZEROPAGE_MODE_DONTWAKE               = 0x1
+	UFFD_API                                    = 0xaa
+	UFFD_EVENT_FORK                             = 0x13
+	UFFD_EVENT_PAGEFAULT                        = 0x12
+	UFFD_EVENT_REMAP                            = 0x14
+	UFFD_EVENT_REMOVE                           = 0x15
+	UFFD_EVENT_UNMAP                            = 0x16
+	UFFD_FEATURE_EVENT_FORK                     = 0x2
+	UFFD_FEATURE_EVENT_REMAP                    = 0x4
+	UFFD_FEATURE_EVENT_REMOVE                   = 0x8
+	UFFD_FEATURE_EVENT_UNMAP                    = 0x40
+	UFFD_FEATURE_EXACT_ADDRESS                  = 0x800
+	UFFD_FEATURE_MINOR_HUGETLBFS                = 0x200
+	UFFD_FEATURE_MINOR_SHMEM                    = 0x400
+	UFFD_FEATURE_MISSING_HUGETLBFS              = 0x10
+	UFFD_FEATURE_MISSING_SHMEM                  = 0x20
+	UFFD_FEATURE_PAGEFAULT_FLAG_WP              = 0x1
+	UFFD_FEATURE_SIGBUS                         = 0x80
+	UFFD_FEATURE_THREAD_ID                      = 0x100
+	UFFD_FEATURE_WP_HUGETLBFS_SHMEM             = 0x1000
+	UFFD_PAGEFAULT_FLAG_MINOR                   = 0x4
+	UFFD_PAGEFAULT_FLAG_WP                      = 0x2
+	UFFD_PAGEFAULT_FLAG_WRITE                   = 0x1
+	UFFD_USER_MODE_ONLY                         = 0x1
 	UMOUNT_NOFOLLOW                             = 0x8
 	USBDEVICE_SUPER_MAGIC                       = 0x9fa2
 	UTIME_NOW                                   = 0x3fffffff
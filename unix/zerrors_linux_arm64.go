@@ -535,6 +535,14 @@ const (
 	UBI_IOCVOLCRBLK                  = 0x40804f07
 	UBI_IOCVOLRMBLK                  = 0x4f08
 	UBI_IOCVOLUP                     = 0x40084f00
+	UFFDIO_API                       = 0xc018aa3f
+	UFFDIO_CONTINUE                  = 0xc020aa07
+	UFFDIO_COPY                      = 0xc028aa03
+	UFFDIO_REGISTER                  = 0xc020aa00
+	UFFDIO_UNREGISTER                = 0x8010aa01
+	UFFDIO_WAKE                      = 0x8010aa02
+	UFFDIO_WRITEPROTECT              = 0xc018aa06
+	UFFDIO_ZEROPAGE                  = 0xc020aa04
 	VDISCARD                         = 0xd
 	VEOF                             = 0x4
 	VEOL                             = 0xb
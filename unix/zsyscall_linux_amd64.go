@@ -643,6 +643,26 @@ func kexecFileLoad(kernelFd int, initrdFd int, cmdlineLen int, cmdline string, f
 
 // THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
 
+func archPrctl(code int, addr uintptr) (err error) {
+	_, _, e1 := Syscall(SYS_ARCH_PRCTL, uintptr(code), uintptr(addr), 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func archPrctlPtr(code int, addr unsafe.Pointer) (err error) {
+	_, _, e1 := Syscall(SYS_ARCH_PRCTL, uintptr(code), uintptr(addr), 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
 func Alarm(seconds uint) (remaining uint, err error) {
 	r0, _, e1 := Syscall(SYS_ALARM, uintptr(seconds), 0, 0)
 	remaining = uint(r0)
@@ -454,6 +454,10 @@ const (
 	POLLWRNORM = 0x4
 )
 
+type Sigset_t struct {
+	Val [4]uint32
+}
+
 type Utsname struct {
 	Sysname  [32]byte
 	Nodename [32]byte
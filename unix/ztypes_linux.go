@@ -744,6 +744,34 @@ type IfaCacheinfo struct {
 	Tstamp   uint32
 }
 
+type LinkStats64 struct {
+	Rx_packets           uint64
+	Tx_packets           uint64
+	Rx_bytes             uint64
+	Tx_bytes             uint64
+	Rx_errors            uint64
+	Tx_errors            uint64
+	Rx_dropped           uint64
+	Tx_dropped           uint64
+	Multicast            uint64
+	Collisions           uint64
+	Rx_length_errors     uint64
+	Rx_over_errors       uint64
+	Rx_crc_errors        uint64
+	Rx_frame_errors      uint64
+	Rx_fifo_errors       uint64
+	Rx_missed_errors     uint64
+	Tx_aborted_errors    uint64
+	Tx_carrier_errors    uint64
+	Tx_fifo_errors       uint64
+	Tx_heartbeat_errors  uint64
+	Tx_window_errors     uint64
+	Rx_compressed        uint64
+	Tx_compressed        uint64
+	Rx_nohandler         uint64
+	Rx_otherhost_dropped uint64
+}
+
 type RtMsg struct {
 	Family   uint8
 	Dst_len  uint8
@@ -886,6 +914,7 @@ const SizeofOpenHow = 0x18
 
 const (
 	RESOLVE_BENEATH       = 0x8
+	RESOLVE_CACHED        = 0x20
 	RESOLVE_IN_ROOT       = 0x10
 	RESOLVE_NO_MAGICLINKS = 0x2
 	RESOLVE_NO_SYMLINKS   = 0x4
@@ -1085,6 +1114,14 @@ type PerfEventMmapPage struct {
 	Aux_size       uint64
 }
 
+type PerfEventHeader struct {
+	Type uint32
+	Misc uint16
+	Size uint16
+}
+
+const SizeofPerfEventHeader = 0x8
+
 const (
 	PerfBitDisabled               uint64 = CBitFieldMaskBit0
 	PerfBitInherit                       = CBitFieldMaskBit1
@@ -1976,6 +2013,11 @@ const (
 	IFLA_DSA_MASTER                            = 0x1
 )
 
+const (
+	VETH_INFO_UNSPEC = 0x0
+	VETH_INFO_PEER   = 0x1
+)
+
 const (
 	NETKIT_NEXT     = -0x1
 	NETKIT_PASS     = 0x0
@@ -3040,6 +3082,94 @@ const (
 	BPF_F_TIMER_ABS                            = 0x1
 )
 
+type BPFMapCreateAttr struct {
+	Map_type                  uint32
+	Key_size                  uint32
+	Value_size                uint32
+	Max_entries               uint32
+	Map_flags                 uint32
+	Inner_map_fd              uint32
+	Numa_node                 uint32
+	Map_name                  [16]uint8
+	Map_ifindex               uint32
+	Btf_fd                    uint32
+	Btf_key_type_id           uint32
+	Btf_value_type_id         uint32
+	Btf_vmlinux_value_type_id uint32
+	Map_extra                 uint64
+}
+
+type BPFMapElemAttr struct {
+	Map_fd uint32
+	_      [4]byte
+	Key    uint64
+	Value  uint64
+	Flags  uint64
+}
+
+type BPFMapBatchAttr struct {
+	In_batch   uint64
+	Out_batch  uint64
+	Keys       uint64
+	Values     uint64
+	Count      uint32
+	Map_fd     uint32
+	Elem_flags uint64
+	Flags      uint64
+}
+
+type BPFProgLoadAttr struct {
+	Prog_type            uint32
+	Insn_cnt             uint32
+	Insns                uint64
+	License              uint64
+	Log_level            uint32
+	Log_size             uint32
+	Log_buf              uint64
+	Kern_version         uint32
+	Prog_flags           uint32
+	Prog_name            [16]uint8
+	Prog_ifindex         uint32
+	Expected_attach_type uint32
+	Prog_btf_fd          uint32
+	Func_info_rec_size   uint32
+	Func_info            uint64
+	Func_info_cnt        uint32
+	Line_info_rec_size   uint32
+	Line_info            uint64
+	Line_info_cnt        uint32
+	Attach_btf_id        uint32
+	Attach_prog_fd       uint32
+	Core_relo_cnt        uint32
+	Fd_array             uint64
+	Core_relos           uint64
+	Core_relo_rec_size   uint32
+	_                    [4]byte
+}
+
+type BPFObjAttr struct {
+	Pathname   uint64
+	Bpf_fd     uint32
+	File_flags uint32
+}
+
+type BPFLinkCreateAttr struct {
+	Prog_fd       uint32
+	Target_fd     uint32
+	Attach_type   uint32
+	Flags         uint32
+	Target_btf_id uint32
+}
+
+type BPFInsn struct {
+	Code uint8
+	Regs uint8
+	Off  int16
+	Imm  int32
+}
+
+const SizeofBPFInsn = 0x8
+
 const (
 	RTNLGRP_NONE          = 0x0
 	RTNLGRP_LINK          = 0x1
@@ -4408,8 +4538,14 @@ type LandlockPathBeneathAttr struct {
 	Parent_fd      int32
 }
 
+type LandlockNetPortAttr struct {
+	Allowed_access uint64
+	Port           uint64
+}
+
 const (
 	LANDLOCK_RULE_PATH_BENEATH = 0x1
+	LANDLOCK_RULE_NET_PORT     = 0x2
 )
 
 const (
@@ -4439,6 +4575,54 @@ type MountAttr struct {
 	Userns_fd   uint64
 }
 
+type MntIdReq struct {
+	Size      uint32
+	Spare     uint32
+	Mnt_id    uint64
+	Param     uint64
+	Mnt_ns_id uint64
+}
+
+type Statmount_t struct {
+	Size              uint32
+	Mnt_opts          uint32
+	Mask              uint64
+	Sb_dev_major      uint32
+	Sb_dev_minor      uint32
+	Sb_magic          uint64
+	Sb_flags          uint32
+	Fs_type           uint32
+	Mnt_id            uint64
+	Mnt_parent_id     uint64
+	Mnt_id_old        uint32
+	Mnt_parent_id_old uint32
+	Mnt_attr          uint64
+	Mnt_propagation   uint64
+	Mnt_peer_group    uint64
+	Mnt_master        uint64
+	Propagate_from    uint64
+	Mnt_root          uint32
+	Mnt_point         uint32
+	Mnt_ns_id         uint64
+	Fs_subtype        uint32
+	Sb_source         uint32
+	Opt_num           uint32
+	Opt_array         uint32
+	Opt_sec_num       uint32
+	Opt_sec_array     uint32
+	Supported_mask    uint64
+	Mnt_uidmap_num    uint32
+	Mnt_uidmap        uint32
+	Mnt_gidmap_num    uint32
+	Mnt_gidmap        uint32
+	_                 [43]uint64
+}
+
+const (
+	SizeofMntIdReq  = 0x20
+	SizeofStatmount = 0x200
+)
+
 const (
 	WG_CMD_GET_DEVICE                      = 0x0
 	WG_CMD_SET_DEVICE                      = 0x1
@@ -6132,6 +6316,20 @@ type SchedAttr struct {
 
 const SizeofSchedAttr = 0x38
 
+type CloneArgs struct {
+	Flags        uint64
+	Pidfd        uint64
+	Child_tid    uint64
+	Parent_tid   uint64
+	Exit_signal  uint64
+	Stack        uint64
+	Stack_size   uint64
+	Tls          uint64
+	Set_tid      uint64
+	Set_tid_size uint64
+	Cgroup       uint64
+}
+
 type Cachestat_t struct {
 	Cache            uint64
 	Dirty            uint64
@@ -6170,9 +6368,318 @@ const (
 	SK_DIAG_BPF_STORAGE_MAP_VALUE  = 0x3
 )
 
+type SockMemInfo struct {
+	Rmem_alloc  uint32
+	Rcvbuf      uint32
+	Wmem_alloc  uint32
+	Sndbuf      uint32
+	Fwd_alloc   uint32
+	Wmem_queued uint32
+	Optmem      uint32
+	Backlog     uint32
+	Drops       uint32
+}
+
+const SizeofSockMemInfo = 0x24
+
 type SockDiagReq struct {
 	Family   uint8
 	Protocol uint8
 }
 
+type InetDiagSockID struct {
+	Sport  uint16
+	Dport  uint16
+	Src    [4]uint32
+	Dst    [4]uint32
+	If     uint32
+	Cookie [2]uint32
+}
+
+type InetDiagReqV2 struct {
+	Sdiag_family   uint8
+	Sdiag_protocol uint8
+	Idiag_ext      uint8
+	Pad            uint8
+	Idiag_states   uint32
+	Id             InetDiagSockID
+}
+
+type InetDiagMsg struct {
+	Family  uint8
+	State   uint8
+	Timer   uint8
+	Retrans uint8
+	Id      InetDiagSockID
+	Expires uint32
+	Rqueue  uint32
+	Wqueue  uint32
+	Uid     uint32
+	Inode   uint32
+}
+
+const (
+	SizeofInetDiagReqV2 = 0x38
+	SizeofInetDiagMsg   = 0x48
+)
+
+const (
+	TCP_ESTABLISHED  = 0x1
+	TCP_SYN_SENT     = 0x2
+	TCP_SYN_RECV     = 0x3
+	TCP_FIN_WAIT1    = 0x4
+	TCP_FIN_WAIT2    = 0x5
+	TCP_TIME_WAIT    = 0x6
+	TCP_CLOSE        = 0x7
+	TCP_CLOSE_WAIT   = 0x8
+	TCP_LAST_ACK     = 0x9
+	TCP_LISTEN       = 0xa
+	TCP_CLOSING      = 0xb
+	TCP_NEW_SYN_RECV = 0xc
+)
+
+type SeccompData struct {
+	Nr                  int32
+	Arch                uint32
+	Instruction_pointer uint64
+	Args                [6]uint64
+}
+
+type SeccompNotif struct {
+	Id    uint64
+	Pid   uint32
+	Flags uint32
+	Data  SeccompData
+}
+
+type SeccompNotifResp struct {
+	Id    uint64
+	Val   int64
+	Error int32
+	Flags uint32
+}
+
+type SeccompNotifAddfd struct {
+	Id          uint64
+	Flags       uint32
+	Srcfd       uint32
+	Newfd       uint32
+	Newfd_flags uint32
+}
+
+type SeccompNotifSizes struct {
+	Seccomp_notif      uint16
+	Seccomp_notif_resp uint16
+	Seccomp_data       uint16
+}
+
+const (
+	SizeofSeccompData       = 0x40
+	SizeofSeccompNotif      = 0x50
+	SizeofSeccompNotifResp  = 0x18
+	SizeofSeccompNotifAddfd = 0x18
+)
+
+type IoUringParams struct {
+	Sq_entries     uint32
+	Cq_entries     uint32
+	Flags          uint32
+	Sq_thread_cpu  uint32
+	Sq_thread_idle uint32
+	Features       uint32
+	Wq_fd          uint32
+	Resv           [3]uint32
+	Sq_off         IoSqringOffsets
+	Cq_off         IoCqringOffsets
+}
+
+type IoSqringOffsets struct {
+	Head         uint32
+	Tail         uint32
+	Ring_mask    uint32
+	Ring_entries uint32
+	Flags        uint32
+	Dropped      uint32
+	Array        uint32
+	Resv1        uint32
+	Resv2        uint64
+}
+
+type IoCqringOffsets struct {
+	Head         uint32
+	Tail         uint32
+	Ring_mask    uint32
+	Ring_entries uint32
+	Overflow     uint32
+	Cqes         uint32
+	Flags        uint32
+	Resv1        uint32
+	Resv2        uint64
+}
+
+type IoUringSqe struct {
+	Opcode       uint8
+	Flags        uint8
+	Ioprio       uint16
+	Fd           int32
+	Off          uint64
+	Addr         uint64
+	Len          uint32
+	Op_flags     uint32
+	User_data    uint64
+	Buf_index    uint16
+	Personality  uint16
+	Splice_fd_in int32
+	Addr3        uint64
+	_            [1]uint64
+}
+
+type IoUringCqe struct {
+	User_data uint64
+	Res       int32
+	Flags     uint32
+}
+
+const (
+	SizeofIoUringParams = 0x78
+	SizeofIoUringSqe    = 0x40
+	SizeofIoUringCqe    = 0x10
+)
+
+const (
+	IORING_OP_NOP                    = 0x0
+	IORING_OP_READV                  = 0x1
+	IORING_OP_WRITEV                 = 0x2
+	IORING_OP_FSYNC                  = 0x3
+	IORING_OP_READ_FIXED             = 0x4
+	IORING_OP_WRITE_FIXED            = 0x5
+	IORING_OP_POLL_ADD               = 0x6
+	IORING_OP_POLL_REMOVE            = 0x7
+	IORING_OP_SYNC_FILE_RANGE        = 0x8
+	IORING_OP_SENDMSG                = 0x9
+	IORING_OP_RECVMSG                = 0xa
+	IORING_OP_TIMEOUT                = 0xb
+	IORING_OP_TIMEOUT_REMOVE         = 0xc
+	IORING_OP_ACCEPT                 = 0xd
+	IORING_OP_ASYNC_CANCEL           = 0xe
+	IORING_OP_LINK_TIMEOUT           = 0xf
+	IORING_OP_CONNECT                = 0x10
+	IORING_OP_FALLOCATE              = 0x11
+	IORING_OP_OPENAT                 = 0x12
+	IORING_OP_CLOSE                  = 0x13
+	IORING_OP_FILES_UPDATE           = 0x14
+	IORING_OP_STATX                  = 0x15
+	IORING_OP_READ                   = 0x16
+	IORING_OP_WRITE                  = 0x17
+	IORING_OP_FADVISE                = 0x18
+	IORING_OP_MADVISE                = 0x19
+	IORING_OP_SEND                   = 0x1a
+	IORING_OP_RECV                   = 0x1b
+	IORING_OP_OPENAT2                = 0x1c
+	IORING_OP_EPOLL_CTL              = 0x1d
+	IORING_OP_SPLICE                 = 0x1e
+	IORING_OP_PROVIDE_BUFFERS        = 0x1f
+	IORING_OP_REMOVE_BUFFERS         = 0x20
+	IORING_OP_TEE                    = 0x21
+	IORING_OP_SHUTDOWN               = 0x22
+	IORING_OP_RENAMEAT               = 0x23
+	IORING_OP_UNLINKAT               = 0x24
+	IORING_OP_MKDIRAT                = 0x25
+	IORING_OP_SYMLINKAT              = 0x26
+	IORING_OP_LINKAT                 = 0x27
+	IORING_OP_MSG_RING               = 0x28
+	IORING_OP_FSETXATTR              = 0x29
+	IORING_OP_SETXATTR               = 0x2a
+	IORING_OP_FGETXATTR              = 0x2b
+	IORING_OP_GETXATTR               = 0x2c
+	IORING_OP_SOCKET                 = 0x2d
+	IORING_OP_URING_CMD              = 0x2e
+	IORING_OP_SEND_ZC                = 0x2f
+	IORING_OP_SENDMSG_ZC             = 0x30
+	IORING_OP_LAST                   = 0x31
+	IORING_REGISTER_BUFFERS          = 0x0
+	IORING_UNREGISTER_BUFFERS        = 0x1
+	IORING_REGISTER_FILES            = 0x2
+	IORING_UNREGISTER_FILES          = 0x3
+	IORING_REGISTER_EVENTFD          = 0x4
+	IORING_UNREGISTER_EVENTFD        = 0x5
+	IORING_REGISTER_FILES_UPDATE     = 0x6
+	IORING_REGISTER_EVENTFD_ASYNC    = 0x7
+	IORING_REGISTER_PROBE            = 0x8
+	IORING_REGISTER_PERSONALITY      = 0x9
+	IORING_UNREGISTER_PERSONALITY    = 0xa
+	IORING_REGISTER_RESTRICTIONS     = 0xb
+	IORING_REGISTER_ENABLE_RINGS     = 0xc
+	IORING_REGISTER_FILES2           = 0xd
+	IORING_REGISTER_FILES_UPDATE2    = 0xe
+	IORING_REGISTER_BUFFERS2         = 0xf
+	IORING_REGISTER_BUFFERS_UPDATE   = 0x10
+	IORING_REGISTER_IOWQ_AFF         = 0x11
+	IORING_UNREGISTER_IOWQ_AFF       = 0x12
+	IORING_REGISTER_IOWQ_MAX_WORKERS = 0x13
+	IORING_REGISTER_RING_FDS         = 0x14
+	IORING_UNREGISTER_RING_FDS       = 0x15
+	IORING_REGISTER_PBUF_RING        = 0x16
+	IORING_UNREGISTER_PBUF_RING      = 0x17
+	IORING_REGISTER_SYNC_CANCEL      = 0x18
+	IORING_REGISTER_FILE_ALLOC_RANGE = 0x19
+)
+
+type UffdioApi struct {
+	Api      uint64
+	Features uint64
+	Ioctls   uint64
+}
+
+type UffdioRange struct {
+	Start uint64
+	Len   uint64
+}
+
+type UffdioRegister struct {
+	Range  UffdioRange
+	Mode   uint64
+	Ioctls uint64
+}
+
+type UffdioCopy struct {
+	Dst  uint64
+	Src  uint64
+	Len  uint64
+	Mode uint64
+	Copy int64
+}
+
+type UffdioZeropage struct {
+	Range    UffdioRange
+	Mode     uint64
+	Zeropage int64
+}
+
+type UffdioWriteprotect struct {
+	Range UffdioRange
+	Mode  uint64
+}
+
+type UffdioContinue struct {
+	Range  UffdioRange
+	Mode   uint64
+	Mapped int64
+}
+
+type UffdMsg struct {
+	Event     uint8
+	Reserved1 uint8
+	Reserved2 uint16
+	Reserved3 uint32
+	Arg       [3]uint64
+}
+
+const (
+	SizeofUffdioApi      = 0x18
+	SizeofUffdioRegister = 0x20
+	SizeofUffdioCopy     = 0x28
+	SizeofUffdMsg        = 0x20
+)
+
 const RTM_NEWNVLAN = 0x70
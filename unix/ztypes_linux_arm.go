@@ -257,6 +257,12 @@ type Siginfo struct {
 	_     [116]byte
 }
 
+type Stack_t struct {
+	Sp    *byte
+	Flags int32
+	Size  uint32
+}
+
 type Termios struct {
 	Iflag  uint32
 	Oflag  uint32
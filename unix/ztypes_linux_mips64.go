@@ -264,6 +264,13 @@ type Siginfo struct {
 	_     [112]byte
 }
 
+type Stack_t struct {
+	Sp    *byte
+	Size  uint64
+	Flags int32
+	_     [4]byte
+}
+
 type Termios struct {
 	Iflag  uint32
 	Oflag  uint32
@@ -262,6 +262,12 @@ type Siginfo struct {
 	_     [116]byte
 }
 
+type Stack_t struct {
+	Sp    *byte
+	Size  uint32
+	Flags int32
+}
+
 type Termios struct {
 	Iflag  uint32
 	Oflag  uint32
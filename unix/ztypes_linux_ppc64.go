@@ -271,6 +271,13 @@ type Siginfo struct {
 	_     [112]byte
 }
 
+type Stack_t struct {
+	Sp    *byte
+	Flags int32
+	_     [4]byte
+	Size  uint64
+}
+
 type Termios struct {
 	Iflag  uint32
 	Oflag  uint32
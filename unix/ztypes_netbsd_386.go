@@ -469,6 +469,10 @@ const (
 	POLLWRNORM = 0x4
 )
 
+type Sigset_t struct {
+	Val [4]uint32
+}
+
 type Sysctlnode struct {
 	Flags           uint32
 	Num             int32